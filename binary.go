@@ -0,0 +1,47 @@
+package utc
+
+import "fmt"
+
+// MarshalBinary implements encoding.BinaryMarshaler. The encoding is the
+// 15-byte format of time.Time.MarshalBinary with the UTC zone, so either type
+// can decode the other's output.
+func (t Time) MarshalBinary() ([]byte, error) {
+	return t.utc().MarshalBinary()
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler. It accepts the
+// output of time.Time.MarshalBinary in any zone and stores the instant in UTC.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if t == nil {
+		debugLog("UnmarshalBinary() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal binary into nil utc.Time")
+	}
+	return t.decodeBinary(data)
+}
+
+// GobEncode implements gob.GobEncoder using the MarshalBinary encoding, so
+// structs with utc.Time fields can be sent with encoding/gob.
+func (t Time) GobEncode() ([]byte, error) {
+	return t.MarshalBinary()
+}
+
+// GobDecode implements gob.GobDecoder.
+func (t *Time) GobDecode(data []byte) error {
+	if t == nil {
+		debugLog("GobDecode() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot gob-decode into nil utc.Time")
+	}
+	return t.decodeBinary(data)
+}
+
+func (t *Time) decodeBinary(data []byte) error {
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty binary into utc.Time")
+	}
+	var decoded Time
+	if err := decoded.t.UnmarshalBinary(data); err != nil {
+		return fmt.Errorf("cannot unmarshal binary into utc.Time: %w", err)
+	}
+	*t = New(decoded.t)
+	return nil
+}
@@ -0,0 +1,126 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_BinaryRoundTrip(t *testing.T) {
+	for _, ut := range []Time{
+		{},
+		New(time.Date(2024, 2, 29, 23, 59, 59, 123456789, time.UTC)),
+		New(time.Date(1, 1, 1, 0, 0, 0, 1, time.UTC)),
+		Now(),
+		PositiveInfinity,
+		NegativeInfinity,
+	} {
+		data, err := ut.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary(%v) error = %v", ut, err)
+		}
+		var got Time
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(%v) error = %v", ut, err)
+		}
+		if got != ut.StripMonotonic() {
+			t.Errorf("binary round trip = %v, want %v", got, ut)
+		}
+	}
+}
+
+func TestTime_BinaryInterop(t *testing.T) {
+	instant := time.Date(2024, 7, 4, 12, 30, 15, 500, time.FixedZone("", -4*3600))
+
+	// time.Time output in any zone decodes to the same instant in UTC.
+	for _, loc := range []*time.Location{time.UTC, instant.Location(), time.FixedZone("", 5*3600+30*60+7)} {
+		data, err := instant.In(loc).MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Time
+		if err := got.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary(time.Time in %v) error = %v", loc, err)
+		}
+		if !got.Time().Equal(instant) || got.Time().Location() != time.UTC || got != New(instant) {
+			t.Errorf("UnmarshalBinary(time.Time in %v) = %v, want %v in UTC", loc, got, instant)
+		}
+	}
+
+	// utc.Time output is byte-for-byte time.Time's UTC encoding.
+	ut := New(instant)
+	data, err := ut.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ := instant.UTC().MarshalBinary()
+	if !bytes.Equal(data, want) {
+		t.Errorf("MarshalBinary() = %x, want %x", data, want)
+	}
+	var std time.Time
+	if err := std.UnmarshalBinary(data); err != nil || !std.Equal(instant) || std.Location() != time.UTC {
+		t.Errorf("time.Time.UnmarshalBinary() = %v, %v", std, err)
+	}
+}
+
+func TestTime_Gob(t *testing.T) {
+	type event struct {
+		Name string
+		At   Time
+		Ptr  *Time
+	}
+	at := New(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC))
+	in := event{Name: "deploy", At: at, Ptr: &at}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("gob Encode() error = %v", err)
+	}
+	var out event
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil {
+		t.Fatalf("gob Decode() error = %v", err)
+	}
+	if out.Name != in.Name || out.At != at || out.Ptr == nil || *out.Ptr != at {
+		t.Errorf("gob round trip = %+v, want %+v", out, in)
+	}
+
+	// A time.Time value decodes into a utc.Time field, since both use
+	// GobEncode with the same format.
+	buf.Reset()
+	if err := gob.NewEncoder(&buf).Encode(struct{ At time.Time }{at.Time().In(time.FixedZone("", 3600))}); err != nil {
+		t.Fatal(err)
+	}
+	var mixed struct{ At Time }
+	if err := gob.NewDecoder(&buf).Decode(&mixed); err != nil {
+		t.Fatalf("gob Decode(time.Time) error = %v", err)
+	}
+	if mixed.At != at {
+		t.Errorf("gob time.Time -> utc.Time = %v, want %v", mixed.At, at)
+	}
+}
+
+func TestTime_BinaryErrors(t *testing.T) {
+	var nilTime *Time
+	if err := nilTime.UnmarshalBinary([]byte{1}); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalBinary() error = %v, want ErrNilReceiver", err)
+	}
+	if err := nilTime.GobDecode([]byte{1}); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil GobDecode() error = %v, want ErrNilReceiver", err)
+	}
+
+	ut := Now()
+	if err := ut.UnmarshalBinary(nil); !errors.Is(err, ErrEmpty) {
+		t.Errorf("UnmarshalBinary(nil) error = %v, want ErrEmpty", err)
+	}
+	for _, data := range [][]byte{{99}, {1, 2, 3}} {
+		before := ut
+		if err := ut.GobDecode(data); err == nil {
+			t.Errorf("GobDecode(%x) succeeded", data)
+		}
+		if ut != before {
+			t.Errorf("failed GobDecode(%x) modified the receiver", data)
+		}
+	}
+}
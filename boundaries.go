@@ -0,0 +1,313 @@
+package utc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Unit names a calendar period used by the StartOf and EndOf boundary helpers.
+type Unit int
+
+// Calendar units.
+const (
+	UnitHour     Unit = iota + 1 // clock hour
+	UnitDay                      // calendar day
+	UnitWeek                     // ISO 8601 week, starting on Monday
+	UnitMonth                    // calendar month
+	UnitQuarter                  // calendar quarter: Jan, Apr, Jul, Oct
+	UnitHalfYear                 // calendar half-year: Jan, Jul
+	UnitYear                     // calendar year
+)
+
+// String implements fmt.Stringer.
+func (u Unit) String() string {
+	switch u {
+	case UnitHour:
+		return "hour"
+	case UnitDay:
+		return "day"
+	case UnitWeek:
+		return "week"
+	case UnitMonth:
+		return "month"
+	case UnitQuarter:
+		return "quarter"
+	case UnitHalfYear:
+		return "half-year"
+	case UnitYear:
+		return "year"
+	default:
+		return fmt.Sprintf("Unit(%d)", int(u))
+	}
+}
+
+// StartOf returns the first instant of the UTC calendar unit containing t.
+// Weeks start on Monday. Unknown units return t unchanged.
+func (t Time) StartOf(u Unit) Time {
+	return t.StartOfIn(u, time.UTC)
+}
+
+// EndOf returns the last nanosecond of the UTC calendar unit containing t.
+// Weeks start on Monday. Unknown units return t unchanged.
+func (t Time) EndOf(u Unit) Time {
+	return t.EndOfIn(u, time.UTC)
+}
+
+// StartOfIn returns the UTC instant at which the calendar unit containing t
+// begins in loc, such as the start of the Pacific day. Weeks start on Monday.
+// A nil loc is treated as UTC. Unknown units, PositiveInfinity, and
+// NegativeInfinity return t unchanged.
+func (t Time) StartOfIn(u Unit, loc *time.Location) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(startOf(t.local(loc), u, time.Monday))
+}
+
+// EndOfIn returns the UTC instant of the last nanosecond of the calendar unit
+// containing t in loc. Weeks start on Monday. A nil loc is treated as UTC.
+// Unknown units, PositiveInfinity, and NegativeInfinity return t unchanged.
+func (t Time) EndOfIn(u Unit, loc *time.Location) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(endOf(t.local(loc), u, time.Monday))
+}
+
+// StartOfDayIn returns the UTC instant at which the calendar day containing t
+// begins in loc, such as the start of the customer's local day for reporting.
+// When local midnight does not exist because of a DST transition, the day
+// begins at the first instant after the transition. A nil loc is treated as UTC.
+func (t Time) StartOfDayIn(loc *time.Location) Time {
+	return t.StartOfIn(UnitDay, loc)
+}
+
+// EndOfDayIn returns the UTC instant of the last nanosecond of the calendar
+// day containing t in loc. A nil loc is treated as UTC.
+func (t Time) EndOfDayIn(loc *time.Location) Time {
+	return t.EndOfIn(UnitDay, loc)
+}
+
+// DayRangeIn returns the half-open interval covering the calendar day
+// containing t in loc. Its duration is 23 or 25 hours on DST transition days.
+// A nil loc is treated as UTC. An infinite t has no calendar day and yields
+// the empty interval at t.
+func (t Time) DayRangeIn(loc *time.Location) Interval {
+	if t.IsInfinite() {
+		return NewInterval(t, t)
+	}
+	local := t.local(loc)
+	start := startOf(local, UnitDay, time.Monday)
+	y, m, d := start.Date()
+	return NewInterval(New(start), New(dayStart(y, m, d+1, start.Location())))
+}
+
+// StartOfHour returns the first instant of the UTC hour containing t.
+func (t Time) StartOfHour() Time {
+	return t.StartOf(UnitHour)
+}
+
+// EndOfHour returns the last nanosecond of the UTC hour containing t.
+func (t Time) EndOfHour() Time {
+	return t.EndOf(UnitHour)
+}
+
+// StartOfWeek returns the first instant of the UTC week containing t, where
+// weeks begin on weekStart. Use time.Monday for ISO 8601 weeks.
+func (t Time) StartOfWeek(weekStart time.Weekday) Time {
+	return t.StartOfWeekIn(weekStart, time.UTC)
+}
+
+// EndOfWeek returns the last nanosecond of the UTC week containing t, where
+// weeks begin on weekStart.
+func (t Time) EndOfWeek(weekStart time.Weekday) Time {
+	return t.EndOfWeekIn(weekStart, time.UTC)
+}
+
+// StartOfWeekIn returns the UTC instant at which the week containing t begins
+// in loc, where weeks begin on weekStart. A nil loc is treated as UTC.
+// PositiveInfinity and NegativeInfinity are returned unchanged.
+func (t Time) StartOfWeekIn(weekStart time.Weekday, loc *time.Location) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(startOf(t.local(loc), UnitWeek, weekStart))
+}
+
+// EndOfWeekIn returns the UTC instant of the last nanosecond of the week
+// containing t in loc, where weeks begin on weekStart. A nil loc is treated as UTC.
+// PositiveInfinity and NegativeInfinity are returned unchanged.
+func (t Time) EndOfWeekIn(weekStart time.Weekday, loc *time.Location) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(endOf(t.local(loc), UnitWeek, weekStart))
+}
+
+// StartOfMonth returns the first instant of the UTC month containing t.
+func (t Time) StartOfMonth() Time {
+	return t.StartOf(UnitMonth)
+}
+
+// EndOfMonth returns the last nanosecond of the UTC month containing t.
+func (t Time) EndOfMonth() Time {
+	return t.EndOf(UnitMonth)
+}
+
+// StartOfQuarter returns the first instant of the UTC quarter containing t.
+func (t Time) StartOfQuarter() Time {
+	return t.StartOf(UnitQuarter)
+}
+
+// EndOfQuarter returns the last nanosecond of the UTC quarter containing t.
+func (t Time) EndOfQuarter() Time {
+	return t.EndOf(UnitQuarter)
+}
+
+// StartOfHalfYear returns the first instant of the UTC half-year containing t.
+func (t Time) StartOfHalfYear() Time {
+	return t.StartOf(UnitHalfYear)
+}
+
+// EndOfHalfYear returns the last nanosecond of the UTC half-year containing t.
+func (t Time) EndOfHalfYear() Time {
+	return t.EndOf(UnitHalfYear)
+}
+
+// StartOfYear returns the first instant of the UTC year containing t.
+func (t Time) StartOfYear() Time {
+	return t.StartOf(UnitYear)
+}
+
+// EndOfYear returns the last nanosecond of the UTC year containing t.
+func (t Time) EndOfYear() Time {
+	return t.EndOf(UnitYear)
+}
+
+// Truncate returns t rounded down to a multiple of d since the zero time.
+// If d <= 0, or t is PositiveInfinity or NegativeInfinity, Truncate returns t
+// unchanged.
+func (t Time) Truncate(d time.Duration) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(t.utc().Truncate(d))
+}
+
+// Round returns t rounded to the nearest multiple of d since the zero time,
+// rounding halfway values up. If d <= 0, or t is PositiveInfinity or
+// NegativeInfinity, Round returns t unchanged.
+func (t Time) Round(d time.Duration) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(t.utc().Round(d))
+}
+
+// local returns t in loc, treating a nil loc as UTC.
+func (t Time) local(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.utc().In(loc)
+}
+
+// startOf returns the first instant of the unit containing t, evaluated on the
+// calendar of t's location.
+func startOf(t time.Time, u Unit, weekStart time.Weekday) time.Time {
+	y, m, d := t.Date()
+	loc := t.Location()
+	switch u {
+	case UnitHour:
+		// Subtract the elapsed part of the hour rather than rebuilding the wall
+		// clock, so the repeated hour of a DST fall-back resolves correctly.
+		return t.Add(-time.Duration(t.Minute())*time.Minute -
+			time.Duration(t.Second())*time.Second -
+			time.Duration(t.Nanosecond()))
+	case UnitDay:
+		return dayStart(y, m, d, loc)
+	case UnitWeek:
+		offset := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return dayStart(y, m, d-offset, loc)
+	case UnitMonth:
+		return dayStart(y, m, 1, loc)
+	case UnitQuarter:
+		return dayStart(y, (m-1)/3*3+1, 1, loc)
+	case UnitHalfYear:
+		return dayStart(y, (m-1)/6*6+1, 1, loc)
+	case UnitYear:
+		return dayStart(y, time.January, 1, loc)
+	default:
+		return t
+	}
+}
+
+// endOf returns the last nanosecond of the unit containing t, evaluated on the
+// calendar of t's location.
+func endOf(t time.Time, u Unit, weekStart time.Weekday) time.Time {
+	start := startOf(t, u, weekStart)
+	y, m, d := start.Date()
+	loc := start.Location()
+	var next time.Time
+	switch u {
+	case UnitHour:
+		next = start.Add(time.Hour)
+	case UnitDay:
+		next = dayStart(y, m, d+1, loc)
+	case UnitWeek:
+		next = dayStart(y, m, d+7, loc)
+	case UnitMonth:
+		next = dayStart(y, m+1, 1, loc)
+	case UnitQuarter:
+		next = dayStart(y, m+3, 1, loc)
+	case UnitHalfYear:
+		next = dayStart(y, m+6, 1, loc)
+	case UnitYear:
+		next = dayStart(y+1, time.January, 1, loc)
+	default:
+		return t
+	}
+	return next.Add(-time.Nanosecond)
+}
+
+// dayStart returns the first instant of the given calendar day in loc.
+// Out-of-range values are normalized the same way time.Date normalizes them.
+//
+// This is usually local midnight, but time.Date does not guarantee which
+// instant it returns when midnight is skipped or repeated by a DST
+// transition, and for skipped midnights it may even return an instant on the
+// previous day. Local dates never decrease as instants increase, so when the
+// fast path fails the first instant of the day is found by binary search.
+// Zone transitions happen on whole seconds, so seconds resolution is exact.
+func dayStart(y int, m time.Month, d int, loc *time.Location) time.Time {
+	target := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	candidate := time.Date(y, m, d, 0, 0, 0, 0, loc)
+	if sameDate(candidate, target) && candidate.Hour() == 0 && candidate.Minute() == 0 &&
+		!sameDate(candidate.Add(-time.Nanosecond), target) {
+		return candidate
+	}
+
+	// onOrAfter reports whether the local date at the given Unix second is
+	// the target date or later.
+	onOrAfter := func(sec int64) bool {
+		ly, lm, ld := time.Unix(sec, 0).In(loc).Date()
+		return !time.Date(ly, lm, ld, 0, 0, 0, 0, time.UTC).Before(target)
+	}
+	lo, hi := candidate.Unix()-2*86400, candidate.Unix()+2*86400
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if onOrAfter(mid) {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+	return time.Unix(lo, 0).In(loc)
+}
+
+// sameDate reports whether t falls on the calendar date of day in t's location.
+func sameDate(t, day time.Time) bool {
+	y, m, d := t.Date()
+	dy, dm, dd := day.Date()
+	return y == dy && m == dm && d == dd
+}
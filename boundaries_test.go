@@ -0,0 +1,235 @@
+package utc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime_Boundaries(t *testing.T) {
+	// Wednesday, 2024-05-15 13:45:30.5 UTC
+	ut := New(time.Date(2024, 5, 15, 13, 45, 30, 500000000, time.UTC))
+	date := func(y int, m time.Month, d, h int) time.Time {
+		return time.Date(y, m, d, h, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name      string
+		start     Time
+		end       Time
+		wantStart time.Time
+		wantNext  time.Time
+	}{
+		{"hour", ut.StartOfHour(), ut.EndOfHour(), date(2024, 5, 15, 13), date(2024, 5, 15, 14)},
+		{"day", ut.StartOf(UnitDay), ut.EndOf(UnitDay), date(2024, 5, 15, 0), date(2024, 5, 16, 0)},
+		{"ISO week", ut.StartOf(UnitWeek), ut.EndOf(UnitWeek), date(2024, 5, 13, 0), date(2024, 5, 20, 0)},
+		{"sunday week", ut.StartOfWeek(time.Sunday), ut.EndOfWeek(time.Sunday), date(2024, 5, 12, 0), date(2024, 5, 19, 0)},
+		{"wednesday week", ut.StartOfWeek(time.Wednesday), ut.EndOfWeek(time.Wednesday), date(2024, 5, 15, 0), date(2024, 5, 22, 0)},
+		{"month", ut.StartOfMonth(), ut.EndOfMonth(), date(2024, 5, 1, 0), date(2024, 6, 1, 0)},
+		{"quarter", ut.StartOfQuarter(), ut.EndOfQuarter(), date(2024, 4, 1, 0), date(2024, 7, 1, 0)},
+		{"half-year", ut.StartOfHalfYear(), ut.EndOfHalfYear(), date(2024, 1, 1, 0), date(2024, 7, 1, 0)},
+		{"year", ut.StartOfYear(), ut.EndOfYear(), date(2024, 1, 1, 0), date(2025, 1, 1, 0)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.start.UTC().Equal(tt.wantStart) {
+				t.Errorf("start = %v, want %v", tt.start, tt.wantStart)
+			}
+			if want := tt.wantNext.Add(-time.Nanosecond); !tt.end.UTC().Equal(want) {
+				t.Errorf("end = %v, want %v", tt.end, want)
+			}
+			if ut.Before(tt.start) || ut.After(tt.end) {
+				t.Errorf("%v is not within [%v, %v]", ut, tt.start, tt.end)
+			}
+		})
+	}
+
+	if got := ut.StartOf(UnitDay); !got.Equal(ut.StartOfDay()) {
+		t.Errorf("StartOf(UnitDay) = %v, want StartOfDay() %v", got, ut.StartOfDay())
+	}
+	if got := ut.EndOf(UnitDay); !got.Equal(ut.EndOfDay()) {
+		t.Errorf("EndOf(UnitDay) = %v, want EndOfDay() %v", got, ut.EndOfDay())
+	}
+	if got := ut.StartOf(Unit(0)); !got.Equal(ut) {
+		t.Errorf("StartOf(unknown) = %v, want unchanged", got)
+	}
+	if got := ut.EndOf(Unit(0)); !got.Equal(ut) {
+		t.Errorf("EndOf(unknown) = %v, want unchanged", got)
+	}
+}
+
+func TestTime_BoundariesQuarterEdges(t *testing.T) {
+	for month, want := range map[time.Month]time.Month{
+		time.January: time.January, time.March: time.January,
+		time.April: time.April, time.June: time.April,
+		time.July: time.July, time.September: time.July,
+		time.October: time.October, time.December: time.October,
+	} {
+		ut := New(time.Date(2023, month, 10, 0, 0, 0, 0, time.UTC))
+		if got := ut.StartOfQuarter().UTC().Month(); got != want {
+			t.Errorf("StartOfQuarter() for %v = %v, want %v", month, got, want)
+		}
+	}
+	dec := New(time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC))
+	if got := dec.EndOfQuarter(); got.UTC().Year() != 2023 || got.DateOnly() != "2023-12-31" {
+		t.Errorf("EndOfQuarter() = %v", got)
+	}
+}
+
+func TestTime_BoundariesIn(t *testing.T) {
+	// 2024-03-10 05:30 UTC is 2024-03-09 21:30 PST, the evening before spring-forward.
+	ut := New(time.Date(2024, 3, 10, 5, 30, 0, 0, time.UTC))
+
+	if got := ut.StartOfIn(UnitDay, pacificLocation); !got.UTC().Equal(time.Date(2024, 3, 9, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOfIn(day, Pacific) = %v", got)
+	}
+	if got := ut.StartOfIn(UnitMonth, pacificLocation); !got.UTC().Equal(time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOfIn(month, Pacific) = %v", got)
+	}
+	// The Pacific week of 2024-03-04 runs Monday 08:00 UTC (PST) to the
+	// following Monday 07:00 UTC (PDT).
+	if got := ut.StartOfIn(UnitWeek, pacificLocation); !got.UTC().Equal(time.Date(2024, 3, 4, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOfIn(week, Pacific) = %v", got)
+	}
+	if got := ut.EndOfIn(UnitWeek, pacificLocation); !got.UTC().Equal(time.Date(2024, 3, 11, 7, 0, 0, -1, time.UTC)) {
+		t.Errorf("EndOfIn(week, Pacific) = %v", got)
+	}
+	if got := ut.StartOfWeekIn(time.Sunday, pacificLocation); !got.UTC().Equal(time.Date(2024, 3, 3, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOfWeekIn(Sunday, Pacific) = %v", got)
+	}
+	if got := ut.EndOfWeekIn(time.Sunday, pacificLocation); !got.UTC().Equal(time.Date(2024, 3, 10, 8, 0, 0, -1, time.UTC)) {
+		t.Errorf("EndOfWeekIn(Sunday, Pacific) = %v", got)
+	}
+	if got := ut.StartOfIn(UnitYear, nil); !got.Equal(ut.StartOfYear()) {
+		t.Errorf("StartOfIn(year, nil) = %v, want UTC %v", got, ut.StartOfYear())
+	}
+
+	// India is UTC+5:30, so local hours begin at half past the UTC hour.
+	india := time.FixedZone("IST", 5*3600+1800)
+	if got := ut.StartOfIn(UnitHour, india); !got.UTC().Equal(time.Date(2024, 3, 10, 5, 30, 0, 0, time.UTC)) {
+		t.Errorf("StartOfIn(hour, IST) = %v", got)
+	}
+	if got := ut.EndOfIn(UnitHour, india); !got.UTC().Equal(time.Date(2024, 3, 10, 6, 30, 0, -1, time.UTC)) {
+		t.Errorf("EndOfIn(hour, IST) = %v", got)
+	}
+	if got := ut.StartOfIn(UnitDay, pacificLocation); got.UTC().Location() != time.UTC {
+		t.Error("StartOfIn() must return a UTC value")
+	}
+}
+
+func TestTime_TruncateRound(t *testing.T) {
+	ut := New(time.Date(2024, 1, 2, 3, 4, 35, 600000000, time.UTC))
+	if got := ut.Truncate(time.Minute); !got.UTC().Equal(time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC)) {
+		t.Errorf("Truncate(minute) = %v", got)
+	}
+	if got := ut.Round(time.Minute); !got.UTC().Equal(time.Date(2024, 1, 2, 3, 5, 0, 0, time.UTC)) {
+		t.Errorf("Round(minute) = %v", got)
+	}
+	if got := ut.Round(time.Second); !got.UTC().Equal(time.Date(2024, 1, 2, 3, 4, 36, 0, time.UTC)) {
+		t.Errorf("Round(second) = %v", got)
+	}
+	if got := ut.Truncate(0); !got.Equal(ut) {
+		t.Errorf("Truncate(0) = %v, want %v", got, ut)
+	}
+}
+
+func TestUnit_String(t *testing.T) {
+	for u, want := range map[Unit]string{
+		UnitHour: "hour", UnitDay: "day", UnitWeek: "week", UnitMonth: "month",
+		UnitQuarter: "quarter", UnitHalfYear: "half-year", UnitYear: "year", Unit(0): "Unit(0)",
+	} {
+		if got := u.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestTime_DayRangeIn(t *testing.T) {
+	santiago, err := time.LoadLocation("America/Santiago")
+	if err != nil {
+		t.Skipf("America/Santiago unavailable: %v", err)
+	}
+	tests := []struct {
+		name      string
+		loc       *time.Location
+		instant   time.Time
+		wantStart time.Time
+		wantLen   time.Duration
+	}{
+		{
+			name:      "Pacific regular day",
+			loc:       pacificLocation,
+			instant:   time.Date(2024, 1, 15, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 1, 15, 8, 0, 0, 0, time.UTC),
+			wantLen:   24 * time.Hour,
+		},
+		{
+			name:      "Pacific spring forward",
+			loc:       pacificLocation,
+			instant:   time.Date(2024, 3, 10, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 3, 10, 8, 0, 0, 0, time.UTC),
+			wantLen:   23 * time.Hour,
+		},
+		{
+			name:      "Eastern fall back",
+			loc:       easternLocation,
+			instant:   time.Date(2024, 11, 3, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 11, 3, 4, 0, 0, 0, time.UTC),
+			wantLen:   25 * time.Hour,
+		},
+		{
+			// Clocks jumped from 00:00 to 01:00, so the day starts at 01:00 -03.
+			name:      "Santiago skipped midnight",
+			loc:       santiago,
+			instant:   time.Date(2022, 9, 11, 15, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2022, 9, 11, 4, 0, 0, 0, time.UTC),
+			wantLen:   23 * time.Hour,
+		},
+		{
+			name:      "Santiago day before skipped midnight",
+			loc:       santiago,
+			instant:   time.Date(2022, 9, 10, 15, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2022, 9, 10, 4, 0, 0, 0, time.UTC),
+			wantLen:   24 * time.Hour,
+		},
+		{
+			// Clocks fell back from 24:00 -03 to 23:00 -04.
+			name:      "Santiago fall back",
+			loc:       santiago,
+			instant:   time.Date(2022, 4, 2, 15, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2022, 4, 2, 3, 0, 0, 0, time.UTC),
+			wantLen:   25 * time.Hour,
+		},
+		{
+			name:      "nil location is UTC",
+			loc:       nil,
+			instant:   time.Date(2024, 1, 15, 20, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+			wantLen:   24 * time.Hour,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ut := New(tt.instant)
+			start := ut.StartOfDayIn(tt.loc)
+			if !start.UTC().Equal(tt.wantStart) {
+				t.Errorf("StartOfDayIn() = %v, want %v", start, tt.wantStart)
+			}
+			wantEnd := tt.wantStart.Add(tt.wantLen - time.Nanosecond)
+			if end := ut.EndOfDayIn(tt.loc); !end.UTC().Equal(wantEnd) {
+				t.Errorf("EndOfDayIn() = %v, want %v", end, wantEnd)
+			}
+			day := ut.DayRangeIn(tt.loc)
+			if !day.Start.Equal(start) || day.Duration() != tt.wantLen {
+				t.Errorf("DayRangeIn() = %v (%v), want start %v and %v", day, day.Duration(), start, tt.wantLen)
+			}
+			if !day.Contains(ut) {
+				t.Errorf("DayRangeIn() = %v does not contain %v", day, ut)
+			}
+		})
+	}
+
+	// Month boundaries resolve local midnight the same way.
+	sep := New(time.Date(2022, 9, 20, 12, 0, 0, 0, time.UTC))
+	if got := sep.StartOfIn(UnitMonth, santiago); !got.UTC().Equal(time.Date(2022, 9, 1, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartOfIn(month, Santiago) = %v", got)
+	}
+}
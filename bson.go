@@ -0,0 +1,68 @@
+package utc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// BSON element types handled by MarshalBSONValue and UnmarshalBSONValue.
+const (
+	bsonString    = 0x02
+	bsonUndefined = 0x06
+	bsonDateTime  = 0x09
+	bsonNull      = 0x0a
+)
+
+// MarshalBSONValue implements the bson.ValueMarshaler interface of
+// go.mongodb.org/mongo-driver/v2. It encodes t as a BSON datetime, which
+// holds whole milliseconds since the Unix epoch; finer precision is
+// truncated. The zero Time encodes as null. PositiveInfinity,
+// NegativeInfinity, and other instants beyond the range of a datetime fail
+// with ErrOutOfRange.
+func (t Time) MarshalBSONValue() (byte, []byte, error) {
+	if t.utc().IsZero() {
+		return bsonNull, nil, nil
+	}
+	if t.IsInfinite() {
+		return 0, nil, fmt.Errorf("cannot write %s utc.Time as BSON datetime: %w", t, ErrOutOfRange)
+	}
+	ms, err := t.UnixMilliChecked()
+	if err != nil {
+		return 0, nil, err
+	}
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(ms))
+	return bsonDateTime, b, nil
+}
+
+// UnmarshalBSONValue implements the bson.ValueUnmarshaler interface of
+// go.mongodb.org/mongo-driver/v2. It accepts BSON datetime values, null and
+// undefined as the zero Time, and strings, which are parsed with the package
+// default parser like UnmarshalText.
+func (t *Time) UnmarshalBSONValue(typ byte, data []byte) error {
+	if t == nil {
+		debugLog("UnmarshalBSONValue() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal BSON into nil utc.Time")
+	}
+
+	switch typ {
+	case bsonDateTime:
+		if len(data) != 8 {
+			return fmt.Errorf("cannot unmarshal BSON datetime of %d bytes into utc.Time", len(data))
+		}
+		t.t = time.UnixMilli(int64(binary.LittleEndian.Uint64(data))).UTC()
+		return nil
+	case bsonNull, bsonUndefined:
+		t.t = time.Time{}
+		return nil
+	case bsonString:
+		// An int32 length that counts the trailing NUL, then the bytes.
+		if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-4 || data[len(data)-1] != 0 {
+			return fmt.Errorf("cannot unmarshal malformed BSON string into utc.Time")
+		}
+		p := currentParser()
+		return p.decodeText(t, data[4:len(data)-1])
+	}
+	return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal BSON type 0x%02x into utc.Time", typ))
+}
@@ -0,0 +1,120 @@
+package utc
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func bsonStringValue(s string) []byte {
+	b := []byte{byte(len(s) + 1), 0, 0, 0}
+	return append(append(b, s...), 0)
+}
+
+func TestTime_MarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		t        Time
+		wantType byte
+		want     []byte
+	}{
+		{"zero", Time{}, bsonNull, nil},
+		{"epoch", Unix(0, 0), bsonDateTime, []byte{0, 0, 0, 0, 0, 0, 0, 0}},
+		{"millis", FromUnixMilli(1700000000123), bsonDateTime, []byte{0x7b, 0x68, 0xe5, 0xcf, 0x8b, 0x01, 0, 0}},
+		{"truncates sub-millisecond", Unix(1700000000, 123999999), bsonDateTime, []byte{0x7b, 0x68, 0xe5, 0xcf, 0x8b, 0x01, 0, 0}},
+		{"before epoch", FromUnixMilli(-1), bsonDateTime, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			typ, data, err := tt.t.MarshalBSONValue()
+			if err != nil {
+				t.Fatalf("MarshalBSONValue() error = %v", err)
+			}
+			if typ != tt.wantType || !bytes.Equal(data, tt.want) {
+				t.Errorf("MarshalBSONValue() = 0x%02x %x, want 0x%02x %x", typ, data, tt.wantType, tt.want)
+			}
+		})
+	}
+
+	for _, ut := range []Time{PositiveInfinity, NegativeInfinity, New(time.Date(300000000, 1, 1, 0, 0, 0, 0, time.UTC))} {
+		if _, _, err := ut.MarshalBSONValue(); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("MarshalBSONValue(%v) error = %v, want ErrOutOfRange", ut, err)
+		}
+	}
+}
+
+func TestTime_UnmarshalBSONValue(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  byte
+		data []byte
+		want Time
+	}{
+		{"datetime", bsonDateTime, []byte{0x7b, 0x68, 0xe5, 0xcf, 0x8b, 0x01, 0, 0}, FromUnixMilli(1700000000123)},
+		{"negative datetime", bsonDateTime, []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, FromUnixMilli(-1)},
+		{"null", bsonNull, nil, Time{}},
+		{"undefined", bsonUndefined, nil, Time{}},
+		{"string", bsonString, bsonStringValue("2024-01-02T03:04:05.5+01:00"), New(time.Date(2024, 1, 2, 2, 4, 5, 500000000, time.UTC))},
+		{"empty string", bsonString, bsonStringValue(""), Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Now()
+			if err := got.UnmarshalBSONValue(tt.typ, tt.data); err != nil {
+				t.Fatalf("UnmarshalBSONValue() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalBSONValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	ut := Unix(1700000000, 123000000)
+	typ, data, err := ut.MarshalBSONValue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var back Time
+	if err := back.UnmarshalBSONValue(typ, data); err != nil || back != ut {
+		t.Errorf("round trip = %v, %v, want %v", back, err, ut)
+	}
+}
+
+func TestTime_UnmarshalBSONValueErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  byte
+		data []byte
+		want error
+	}{
+		{"int32", 0x10, []byte{1, 0, 0, 0}, ErrUnsupportedType},
+		{"document", 0x03, []byte{5, 0, 0, 0, 0}, ErrUnsupportedType},
+		{"short datetime", bsonDateTime, []byte{1, 2, 3}, nil},
+		{"string length mismatch", bsonString, []byte{9, 0, 0, 0, 'a', 0}, nil},
+		{"string without NUL", bsonString, []byte{2, 0, 0, 0, 'a', 'b'}, nil},
+		{"truncated string", bsonString, []byte{1, 0}, nil},
+		{"unparseable string", bsonString, bsonStringValue("yesterday"), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := Unix(42, 0)
+			got := before
+			err := got.UnmarshalBSONValue(tt.typ, tt.data)
+			if err == nil {
+				t.Fatalf("UnmarshalBSONValue() = %v, want error", got)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("UnmarshalBSONValue() error = %v, want %v", err, tt.want)
+			}
+			if got != before {
+				t.Error("failed UnmarshalBSONValue() modified the receiver")
+			}
+		})
+	}
+
+	var nilTime *Time
+	if err := nilTime.UnmarshalBSONValue(bsonNull, nil); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalBSONValue() error = %v, want ErrNilReceiver", err)
+	}
+}
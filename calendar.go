@@ -0,0 +1,142 @@
+package utc
+
+import (
+	"fmt"
+	"time"
+)
+
+// Overflow selects how calendar arithmetic handles a day of the month that
+// does not exist in the target month, such as January 31 plus one month.
+type Overflow int
+
+// Overflow policies.
+const (
+	// OverflowNormalize rolls extra days into the following month, matching
+	// time.Time.AddDate: January 31 plus one month is March 2 or 3.
+	OverflowNormalize Overflow = iota
+	// OverflowClamp clamps to the last day of the target month: January 31
+	// plus one month is February 28 or 29.
+	OverflowClamp
+	// OverflowError reports ErrDateOverflow instead of choosing a date.
+	OverflowError
+)
+
+// String implements fmt.Stringer.
+func (o Overflow) String() string {
+	switch o {
+	case OverflowNormalize:
+		return "normalize"
+	case OverflowClamp:
+		return "clamp"
+	case OverflowError:
+		return "error"
+	default:
+		return fmt.Sprintf("Overflow(%d)", int(o))
+	}
+}
+
+// AddDate returns the time corresponding to adding the given number of years,
+// months, and days to t on the UTC calendar. Like time.Time.AddDate it
+// normalizes overflow, so January 31 plus one month is early March. Use
+// AddDateOverflow to clamp or reject instead. PositiveInfinity and
+// NegativeInfinity are returned unchanged.
+func (t Time) AddDate(years, months, days int) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	return New(t.utc().AddDate(years, months, days))
+}
+
+// AddDateOverflow is like AddDate but applies years and months first using the
+// given overflow policy, then adds days. It returns an error wrapping
+// ErrDateOverflow only when policy is OverflowError and the day of the month
+// does not exist in the target month. PositiveInfinity and NegativeInfinity
+// are returned unchanged.
+func (t Time) AddDateOverflow(years, months, days int, policy Overflow) (Time, error) {
+	if t.IsInfinite() {
+		return t, nil
+	}
+	u, err := addMonths(t.utc(), 12*years+months, policy)
+	if err != nil {
+		return Time{}, err
+	}
+	return New(u.AddDate(0, 0, days)), nil
+}
+
+// AddMonths returns t plus the given number of months using the given overflow
+// policy. With OverflowClamp, renewals anchored on the 31st fall on the last
+// day of shorter months.
+func (t Time) AddMonths(months int, policy Overflow) (Time, error) {
+	return t.AddDateOverflow(0, months, 0, policy)
+}
+
+// AddYears returns t plus the given number of years using the given overflow
+// policy. The policy only matters for February 29.
+func (t Time) AddYears(years int, policy Overflow) (Time, error) {
+	return t.AddDateOverflow(years, 0, 0, policy)
+}
+
+// AddBusinessDays returns t moved by n business days (Monday through Friday on
+// the UTC calendar), keeping the clock time. Negative n moves backwards. When t
+// falls on a weekend, the first business day in the direction of travel counts
+// as the first step. AddBusinessDays(0) returns t unchanged, as do
+// PositiveInfinity and NegativeInfinity.
+func (t Time) AddBusinessDays(n int) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	u := t.utc()
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	for n > 0 {
+		// From a weekday, every full week is exactly five business days.
+		if n >= 5 && !isWeekend(u) {
+			u = u.AddDate(0, 0, 7*step*(n/5))
+			n %= 5
+			continue
+		}
+		u = u.AddDate(0, 0, step)
+		if !isWeekend(u) {
+			n--
+		}
+	}
+	return New(u)
+}
+
+// IsWeekend reports whether t falls on a Saturday or Sunday in UTC.
+func (t Time) IsWeekend() bool {
+	return isWeekend(t.utc())
+}
+
+func isWeekend(t time.Time) bool {
+	wd := t.Weekday()
+	return wd == time.Saturday || wd == time.Sunday
+}
+
+// addMonths adds months to t, resolving days that do not exist in the target
+// month according to policy.
+func addMonths(t time.Time, months int, policy Overflow) (time.Time, error) {
+	if months == 0 {
+		return t, nil
+	}
+	y, m, d := t.Date()
+	hour, minute, sec := t.Clock()
+	first := time.Date(y, m+time.Month(months), 1, hour, minute, sec, t.Nanosecond(), t.Location())
+	if last := daysIn(first.Year(), first.Month()); d > last {
+		switch policy {
+		case OverflowClamp:
+			d = last
+		case OverflowError:
+			return time.Time{}, fmt.Errorf("%s %d has %d days, cannot use day %d: %w",
+				first.Month(), first.Year(), last, d, ErrDateOverflow)
+		}
+	}
+	return first.AddDate(0, 0, d-1), nil
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,159 @@
+package utc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_AddDate(t *testing.T) {
+	jan31 := New(time.Date(2024, 1, 31, 9, 30, 0, 0, time.UTC))
+	if got := jan31.AddDate(0, 1, 0); !got.UTC().Equal(time.Date(2024, 3, 2, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("AddDate(0, 1, 0) = %v, want Go normalization to March 2", got)
+	}
+	if got := jan31.AddDate(1, -1, 1); !got.UTC().Equal(time.Date(2025, 1, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Errorf("AddDate(1, -1, 1) = %v", got)
+	}
+}
+
+func TestTime_AddDateOverflow(t *testing.T) {
+	tests := []struct {
+		name                string
+		start               time.Time
+		years, months, days int
+		policy              Overflow
+		want                time.Time
+		wantErr             bool
+	}{
+		{
+			name:   "normalize matches time.AddDate",
+			start:  time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			months: 1, policy: OverflowNormalize,
+			want: time.Date(2023, 3, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "clamp to February",
+			start:  time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			months: 1, policy: OverflowClamp,
+			want: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "clamp then add days",
+			start:  time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			months: 1, days: 1, policy: OverflowClamp,
+			want: time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "error on missing day",
+			start:  time.Date(2023, 1, 31, 0, 0, 0, 0, time.UTC),
+			months: 1, policy: OverflowError,
+			wantErr: true,
+		},
+		{
+			name:   "error policy with valid day",
+			start:  time.Date(2023, 1, 28, 0, 0, 0, 0, time.UTC),
+			months: 1, policy: OverflowError,
+			want: time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "leap day clamps across years",
+			start: time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC),
+			years: 1, policy: OverflowClamp,
+			want: time.Date(2025, 2, 28, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "negative months clamp",
+			start:  time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC),
+			months: -3, policy: OverflowClamp,
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.start).AddDateOverflow(tt.years, tt.months, tt.days, tt.policy)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("AddDateOverflow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrDateOverflow) {
+					t.Errorf("AddDateOverflow() error = %v, want ErrDateOverflow", err)
+				}
+				return
+			}
+			if !got.UTC().Equal(tt.want) {
+				t.Errorf("AddDateOverflow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_AddMonthsRenewals(t *testing.T) {
+	start := New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	want := []string{"2024-02-29", "2024-03-31", "2024-04-30", "2024-05-31"}
+	for k, w := range want {
+		// Renewals are computed from the anchor date so clamping does not drift.
+		got, err := start.AddMonths(k+1, OverflowClamp)
+		if err != nil {
+			t.Fatalf("AddMonths(%d) error = %v", k+1, err)
+		}
+		if got.DateOnly() != w {
+			t.Errorf("AddMonths(%d) = %s, want %s", k+1, got.DateOnly(), w)
+		}
+	}
+
+	leap := New(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC))
+	if got, err := leap.AddYears(4, OverflowError); err != nil || got.DateOnly() != "2028-02-29" {
+		t.Errorf("AddYears(4) = %v, %v", got, err)
+	}
+	if _, err := leap.AddYears(1, OverflowError); !errors.Is(err, ErrDateOverflow) {
+		t.Errorf("AddYears(1) error = %v, want ErrDateOverflow", err)
+	}
+}
+
+func TestTime_AddBusinessDays(t *testing.T) {
+	// 2024-01-05 is a Friday.
+	friday := New(time.Date(2024, 1, 5, 15, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name  string
+		start Time
+		n     int
+		want  string
+	}{
+		{name: "zero", start: friday, n: 0, want: "2024-01-05"},
+		{name: "over weekend", start: friday, n: 1, want: "2024-01-08"},
+		{name: "one week", start: friday, n: 5, want: "2024-01-12"},
+		{name: "two weeks and two days", start: friday, n: 12, want: "2024-01-23"},
+		{name: "backwards", start: friday, n: -5, want: "2023-12-29"},
+		{name: "backwards over weekend", start: friday.AddDate(0, 0, 3), n: -1, want: "2024-01-05"},
+		{name: "from saturday", start: friday.AddDate(0, 0, 1), n: 1, want: "2024-01-08"},
+		{name: "from saturday one week", start: friday.AddDate(0, 0, 1), n: 5, want: "2024-01-12"},
+		{name: "from sunday backwards", start: friday.AddDate(0, 0, 2), n: -1, want: "2024-01-05"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.start.AddBusinessDays(tt.n)
+			if got.DateOnly() != tt.want {
+				t.Errorf("AddBusinessDays(%d) = %s (%s), want %s", tt.n, got.DateOnly(), got.WeekdayShort(), tt.want)
+			}
+			if got.TimeOnly() != tt.start.TimeOnly() {
+				t.Errorf("AddBusinessDays(%d) changed clock to %s", tt.n, got.TimeOnly())
+			}
+		})
+	}
+	if !friday.AddDate(0, 0, 1).IsWeekend() || friday.IsWeekend() {
+		t.Error("IsWeekend() failed")
+	}
+}
+
+func TestOverflow_String(t *testing.T) {
+	for policy, want := range map[Overflow]string{
+		OverflowNormalize: "normalize",
+		OverflowClamp:     "clamp",
+		OverflowError:     "error",
+		Overflow(9):       "Overflow(9)",
+	} {
+		if got := policy.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
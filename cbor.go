@@ -0,0 +1,224 @@
+package utc
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// CBOR major types and simple values used by the date/time tags.
+const (
+	cborUint    = 0
+	cborNegInt  = 1
+	cborText    = 3
+	cborTag     = 6
+	cborSimple  = 7
+	cborNull    = 0xf6
+	cborUndef   = 0xf7
+	cborTagText = 0 // RFC 3339 date/time string
+	cborTagUnix = 1 // seconds since the Unix epoch
+)
+
+// MarshalCBOR implements the cbor.Marshaler interface of
+// github.com/fxamacker/cbor and compatible libraries. It encodes t with tag 1
+// and integer seconds when t has no fractional second, and otherwise with
+// tag 0 and an RFC 3339 string with nanoseconds, so no precision is lost. The
+// zero Time encodes as null, and PositiveInfinity and NegativeInfinity as
+// tag 1 with floating-point infinities.
+func (t Time) MarshalCBOR() ([]byte, error) {
+	u := t.utc()
+	switch {
+	case u.IsZero():
+		return []byte{cborNull}, nil
+	case t.Equal(PositiveInfinity):
+		return []byte{cborTag<<5 | cborTagUnix, 0xf9, 0x7c, 0x00}, nil
+	case t.Equal(NegativeInfinity):
+		return []byte{cborTag<<5 | cborTagUnix, 0xf9, 0xfc, 0x00}, nil
+	case u.Nanosecond() == 0:
+		b, sec := []byte{cborTag<<5 | cborTagUnix}, u.Unix()
+		if sec < 0 {
+			return cborAppendHead(b, cborNegInt, uint64(-(sec + 1))), nil
+		}
+		return cborAppendHead(b, cborUint, uint64(sec)), nil
+	default:
+		s := u.Format(time.RFC3339Nano)
+		b := cborAppendHead([]byte{cborTag<<5 | cborTagText}, cborText, uint64(len(s)))
+		return append(b, s...), nil
+	}
+}
+
+// UnmarshalCBOR implements the cbor.Unmarshaler interface of
+// github.com/fxamacker/cbor and compatible libraries. It accepts tag 0
+// strings, tag 1 integer and floating-point seconds, the same values untagged,
+// and null or undefined as the zero Time. Untagged strings are parsed with the
+// package default parser like UnmarshalText.
+func (t *Time) UnmarshalCBOR(data []byte) error {
+	if t == nil {
+		debugLog("UnmarshalCBOR() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal CBOR into nil utc.Time")
+	}
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty CBOR into utc.Time")
+	}
+
+	item, err := cborRead(data)
+	if err != nil {
+		return err
+	}
+	tag := -1
+	if item.major == cborTag {
+		if item.arg != cborTagText && item.arg != cborTagUnix {
+			return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal CBOR tag %d into utc.Time", item.arg))
+		}
+		tag = int(item.arg)
+		if item, err = cborRead(item.rest); err != nil {
+			return err
+		}
+	}
+
+	if item.major == cborText {
+		if uint64(len(item.rest)) != item.arg {
+			return fmt.Errorf("cannot unmarshal CBOR into utc.Time: text of %d bytes followed by %d", item.arg, len(item.rest))
+		}
+		switch tag {
+		case cborTagText:
+			parsed, err := time.Parse(time.RFC3339Nano, string(item.rest))
+			if err != nil {
+				return fmt.Errorf("cannot unmarshal CBOR tag 0 into utc.Time: %w", err)
+			}
+			t.t = parsed.UTC()
+			return nil
+		case -1:
+			p := currentParser()
+			return p.decodeText(t, item.rest)
+		}
+		return newError(ErrUnsupportedType, "cannot unmarshal CBOR tag 1 with text content into utc.Time")
+	}
+	if tag == cborTagText {
+		return newError(ErrUnsupportedType, "cannot unmarshal CBOR tag 0 without text content into utc.Time")
+	}
+	if item.major != cborUint && item.major != cborNegInt && item.major != cborSimple {
+		return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal CBOR value with initial byte 0x%02x into utc.Time", data[0]))
+	}
+	if len(item.rest) != 0 {
+		return fmt.Errorf("cannot unmarshal CBOR into utc.Time: %d unexpected trailing bytes", len(item.rest))
+	}
+
+	switch {
+	case item.major == cborUint:
+		if item.arg > uint64(maxUnixSec) {
+			return fmt.Errorf("CBOR epoch time %d: %w", item.arg, ErrOutOfRange)
+		}
+		t.t = time.Unix(int64(item.arg), 0).UTC()
+		return nil
+	case item.major == cborNegInt:
+		if item.arg > math.MaxInt64 {
+			return fmt.Errorf("CBOR epoch time -1-%d: %w", item.arg, ErrOutOfRange)
+		}
+		t.t = time.Unix(-1-int64(item.arg), 0).UTC()
+		return nil
+	case item.major == cborSimple && (data[0] == cborNull || data[0] == cborUndef):
+		t.t = time.Time{}
+		return nil
+	case item.major == cborSimple && item.info >= 25 && item.info <= 27:
+		f := cborFloat(item.info, item.arg)
+		switch {
+		case math.IsInf(f, 1):
+			*t = PositiveInfinity
+		case math.IsInf(f, -1):
+			*t = NegativeInfinity
+		default:
+			parsed, err := FromUnixFloatChecked(f)
+			if err != nil {
+				return fmt.Errorf("CBOR epoch time %v: %w", f, ErrOutOfRange)
+			}
+			*t = parsed
+		}
+		return nil
+	}
+	return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal CBOR value with initial byte 0x%02x into utc.Time", data[0]))
+}
+
+// cborItem is the head of one CBOR data item.
+type cborItem struct {
+	major byte
+	info  byte   // additional information, the low five bits of the head
+	arg   uint64 // argument: a length, integer, tag number, or float bits
+	rest  []byte // bytes after the head
+}
+
+// cborRead decodes the head at the start of data. It rejects
+// indefinite-length items, which no date/time encoding uses.
+func cborRead(data []byte) (cborItem, error) {
+	if len(data) == 0 {
+		return cborItem{}, fmt.Errorf("cannot unmarshal truncated CBOR into utc.Time")
+	}
+	item := cborItem{major: data[0] >> 5, info: data[0] & 0x1f}
+	switch {
+	case item.info < 24:
+		item.arg, item.rest = uint64(item.info), data[1:]
+	case item.info <= 27:
+		n := 1 << (item.info - 24)
+		if len(data) < 1+n {
+			return cborItem{}, fmt.Errorf("cannot unmarshal truncated CBOR into utc.Time")
+		}
+		for _, c := range data[1 : 1+n] {
+			item.arg = item.arg<<8 | uint64(c)
+		}
+		item.rest = data[1+n:]
+	default:
+		return cborItem{}, newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal CBOR value with initial byte 0x%02x into utc.Time", data[0]))
+	}
+	return item, nil
+}
+
+// cborAppendHead appends the head of an item with the given major type and
+// argument in its shortest form.
+func cborAppendHead(b []byte, major byte, arg uint64) []byte {
+	var n int
+	switch {
+	case arg < 24:
+		return append(b, major<<5|byte(arg))
+	case arg <= math.MaxUint8:
+		b, n = append(b, major<<5|24), 1
+	case arg <= math.MaxUint16:
+		b, n = append(b, major<<5|25), 2
+	case arg <= math.MaxUint32:
+		b, n = append(b, major<<5|26), 4
+	default:
+		b, n = append(b, major<<5|27), 8
+	}
+	for i := n - 1; i >= 0; i-- {
+		b = append(b, byte(arg>>(8*i)))
+	}
+	return b
+}
+
+// cborFloat converts the bits of a half-, single-, or double-precision float,
+// selected by info 25, 26, or 27.
+func cborFloat(info byte, bits uint64) float64 {
+	switch info {
+	case 25:
+		exp, frac := int(bits>>10&0x1f), float64(bits&0x3ff)
+		var f float64
+		switch exp {
+		case 0:
+			f = math.Ldexp(frac, -24)
+		case 0x1f:
+			f = math.Inf(1)
+			if frac != 0 {
+				f = math.NaN()
+			}
+		default:
+			f = math.Ldexp(frac+0x400, exp-25)
+		}
+		if bits&0x8000 != 0 {
+			f = -f
+		}
+		return f
+	case 26:
+		return float64(math.Float32frombits(uint32(bits)))
+	default:
+		return math.Float64frombits(bits)
+	}
+}
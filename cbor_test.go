@@ -0,0 +1,128 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_CBOR(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Time
+		hex  string
+	}{
+		{"zero", Time{}, "f6"},
+		// RFC 8949, Appendix A.
+		{"tag 1 seconds", Unix(1363896240, 0), "c11a514b67b0"},
+		{"tag 1 small", Unix(23, 0), "c117"},
+		{"tag 1 negative", Unix(-1, 0), "c120"},
+		{"tag 1 negative large", Unix(-1000000, 0), "c13a000f423f"},
+		{"tag 0 fraction", Unix(0, 5), "c0781e" + hex.EncodeToString([]byte("1970-01-01T00:00:00.000000005Z"))},
+		{"positive infinity", PositiveInfinity, "c1f97c00"},
+		{"negative infinity", NegativeInfinity, "c1f9fc00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := mustHex(t, tt.hex)
+			got, err := tt.t.MarshalCBOR()
+			if err != nil {
+				t.Fatalf("MarshalCBOR() error = %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("MarshalCBOR() = %x, want %x", got, want)
+			}
+			decoded := Now()
+			if err := decoded.UnmarshalCBOR(want); err != nil {
+				t.Fatalf("UnmarshalCBOR() error = %v", err)
+			}
+			if decoded != tt.t {
+				t.Errorf("UnmarshalCBOR() = %v, want %v", decoded, tt.t)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalCBORForms(t *testing.T) {
+	rfcExample := Unix(1363896240, 0)
+	tests := []struct {
+		name string
+		hex  string
+		want Time
+	}{
+		// RFC 8949, Appendix A.
+		{"tag 0", "c074" + hex.EncodeToString([]byte("2013-03-21T20:04:00Z")), rfcExample},
+		{"tag 1 double", "c1fb41d452d9ec200000", rfcExample.Add(500 * time.Millisecond)},
+		{"tag 0 offset", "c07819" + hex.EncodeToString([]byte("2013-03-21T21:04:00+01:00")), rfcExample},
+		{"tag 1 half", "c1f93c00", Unix(1, 0)},
+		{"tag 1 single", "c1fa3fc00000", Unix(1, 500000000)},
+		{"tag 1 non-preferred", "c11b00000000514b67b0", rfcExample},
+		{"long tag head", "d8011a514b67b0", rfcExample},
+		{"untagged integer", "1a514b67b0", rfcExample},
+		{"untagged negative", "20", Unix(-1, 0)},
+		{"untagged float", "fb41d452d9ec200000", rfcExample.Add(500 * time.Millisecond)},
+		{"untagged string", "74" + hex.EncodeToString([]byte("2013-03-21T20:04:00Z")), rfcExample},
+		{"untagged empty string", "60", Time{}},
+		{"undefined", "f7", Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Now()
+			if err := got.UnmarshalCBOR(mustHex(t, tt.hex)); err != nil {
+				t.Fatalf("UnmarshalCBOR() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalCBOR() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalCBORErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want error
+	}{
+		{"empty", "", ErrEmpty},
+		{"other tag", "c21a514b67b0", ErrUnsupportedType},
+		{"tag 0 integer", "c001", ErrUnsupportedType},
+		{"tag 1 text", "c16161", ErrUnsupportedType},
+		{"tagged null", "c1f6", ErrUnsupportedType},
+		{"bool", "f5", ErrUnsupportedType},
+		{"byte string", "4101", ErrUnsupportedType},
+		{"indefinite string", "7f6161ff", ErrUnsupportedType},
+		{"tag only", "c1", nil},
+		{"truncated", "1a514b", nil},
+		{"short text", "c074323031", nil},
+		{"trailing bytes", "c11a514b67b000", nil},
+		{"bad tag 0 text", "c063616263", nil},
+		{"NaN", "c1f97e00", ErrOutOfRange},
+		{"too large", "c11bffffffffffffffff", ErrOutOfRange},
+		{"too small", "c13bffffffffffffffff", ErrOutOfRange},
+		{"float too large", "c1fb7fefffffffffffff", ErrOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := Unix(42, 0)
+			got := before
+			err := got.UnmarshalCBOR(mustHex(t, tt.hex))
+			if err == nil {
+				t.Fatalf("UnmarshalCBOR(%s) = %v, want error", tt.hex, got)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("UnmarshalCBOR(%s) error = %v, want %v", tt.hex, err, tt.want)
+			}
+			if got != before {
+				t.Errorf("failed UnmarshalCBOR(%s) modified the receiver", tt.hex)
+			}
+		})
+	}
+
+	var nilTime *Time
+	if err := nilTime.UnmarshalCBOR([]byte{cborNull}); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalCBOR() error = %v, want ErrNilReceiver", err)
+	}
+}
@@ -0,0 +1,184 @@
+package utc
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is a source of the current time and of timers, so code that depends
+// on time can be tested with a FakeClock instead of sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() Time
+	// Since returns the time elapsed since t, like time.Since.
+	Since(t Time) time.Duration
+	// Until returns the duration until t, like time.Until.
+	Until(t Time) time.Duration
+	// Sleep pauses the calling goroutine for at least d, like time.Sleep.
+	Sleep(d time.Duration)
+	// After waits for d to elapse and then sends the current time on the
+	// returned channel, like time.After.
+	After(d time.Duration) <-chan Time
+	// AfterFunc waits for d to elapse and then calls f, like time.AfterFunc.
+	// RealClock calls f in its own goroutine. The returned Timer's C method
+	// returns nil.
+	AfterFunc(d time.Duration, f func()) Timer
+	// NewTimer returns a Timer that sends the current time on its channel
+	// after at least d.
+	NewTimer(d time.Duration) Timer
+	// NewTicker returns a Ticker that sends the current time on its channel
+	// every d. It panics if d <= 0.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a single event, like time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered.
+	C() <-chan Time
+	// Stop prevents the Timer from firing. It reports whether the call
+	// stopped the timer, as time.Timer.Stop does.
+	Stop() bool
+	// Reset changes the timer to expire after d. It reports whether the
+	// timer had been active, as time.Timer.Reset does.
+	Reset(d time.Duration) bool
+}
+
+// Ticker delivers ticks at intervals, like time.Ticker.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan Time
+	// Stop turns off the ticker. No more ticks are sent after Stop returns.
+	Stop()
+	// Reset stops the ticker and resets its period to d. It panics if d <= 0.
+	Reset(d time.Duration)
+}
+
+// RealClock returns the Clock backed by the system clock and the time package.
+func RealClock() Clock {
+	return realClock{}
+}
+
+type realClock struct{}
+
+func (realClock) Now() Time {
+	return Now()
+}
+
+func (realClock) Since(t Time) time.Duration {
+	return Now().Sub(t)
+}
+
+func (realClock) Until(t Time) time.Duration {
+	return t.Sub(Now())
+}
+
+func (realClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+func (c realClock) After(d time.Duration) <-chan Time {
+	return c.NewTimer(d).C()
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return &realTimer{t: time.AfterFunc(d, f)}
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	t := &realTimer{c: make(chan Time, 1)}
+	t.t = time.AfterFunc(d, t.fire)
+	return t
+}
+
+func (realClock) NewTicker(d time.Duration) Ticker {
+	t := &realTicker{t: time.NewTicker(d), c: make(chan Time, 1)}
+	t.start()
+	return t
+}
+
+// realTimer delivers utc.Time values from a time.AfterFunc timer, which needs
+// no goroutine while it waits. c is nil for timers made by AfterFunc.
+type realTimer struct {
+	t *time.Timer
+	c chan Time
+}
+
+func (t *realTimer) fire() {
+	select {
+	case t.c <- Now():
+	default:
+	}
+}
+
+func (t *realTimer) C() <-chan Time             { return t.c }
+func (t *realTimer) Stop() bool                 { return t.t.Stop() }
+func (t *realTimer) Reset(d time.Duration) bool { return t.t.Reset(d) }
+
+// realTicker forwards ticks from a time.Ticker as utc.Time values.
+type realTicker struct {
+	t *time.Ticker
+	c chan Time
+
+	mu   sync.Mutex
+	done chan struct{} // nil while stopped
+}
+
+func (t *realTicker) start() {
+	t.done = make(chan struct{})
+	go func(done chan struct{}) {
+		for {
+			select {
+			case v := <-t.t.C:
+				select {
+				case t.c <- New(v):
+				default:
+				}
+			case <-done:
+				return
+			}
+		}
+	}(t.done)
+}
+
+func (t *realTicker) C() <-chan Time { return t.c }
+
+func (t *realTicker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.t.Stop()
+	if t.done != nil {
+		close(t.done)
+		t.done = nil
+	}
+}
+
+func (t *realTicker) Reset(d time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.t.Reset(d)
+	if t.done == nil {
+		t.start()
+	}
+}
+
+type clockKey struct{}
+
+// WithClock returns a copy of ctx carrying c, for retrieval with ClockFrom
+// and NowCtx.
+func WithClock(ctx context.Context, c Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, c)
+}
+
+// ClockFrom returns the Clock carried by ctx, or RealClock if there is none.
+func ClockFrom(ctx context.Context) Clock {
+	if c, ok := ctx.Value(clockKey{}).(Clock); ok && c != nil {
+		return c
+	}
+	return RealClock()
+}
+
+// NowCtx returns the current time according to the Clock carried by ctx.
+func NowCtx(ctx context.Context) Time {
+	return ClockFrom(ctx).Now()
+}
@@ -0,0 +1,104 @@
+package utc
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRealClock(t *testing.T) {
+	c := RealClock()
+	before := Now()
+	if now := c.Now(); now.Before(before) || now.Time().Location() != time.UTC {
+		t.Errorf("RealClock().Now() = %v, want UTC at or after %v", now, before)
+	}
+
+	timer := c.NewTimer(time.Millisecond)
+	select {
+	case v := <-timer.C():
+		if v.Before(before) {
+			t.Errorf("timer fired with %v, before %v", v, before)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("real timer did not fire")
+	}
+	if timer.Stop() {
+		t.Error("Stop() after firing = true")
+	}
+	if timer.Reset(time.Hour) {
+		t.Error("Reset() after firing = true")
+	}
+	if !timer.Stop() {
+		t.Error("Stop() on a pending timer = false")
+	}
+
+	ticker := c.NewTicker(time.Millisecond)
+	for i := 0; i < 2; i++ {
+		select {
+		case <-ticker.C():
+		case <-time.After(5 * time.Second):
+			t.Fatal("real ticker did not tick")
+		}
+	}
+	ticker.Stop()
+	ticker.Reset(time.Millisecond)
+	select {
+	case <-ticker.C():
+	case <-time.After(5 * time.Second):
+		t.Fatal("real ticker did not restart after Reset")
+	}
+	ticker.Stop()
+}
+
+func TestRealClock_Helpers(t *testing.T) {
+	c := RealClock()
+	start := c.Now()
+	c.Sleep(time.Millisecond)
+	if d := c.Since(start); d < time.Millisecond {
+		t.Errorf("Since() after Sleep(1ms) = %v", d)
+	}
+	if d := c.Until(start.Add(time.Hour)); d <= 0 || d > time.Hour {
+		t.Errorf("Until(start+1h) = %v", d)
+	}
+
+	select {
+	case <-c.After(time.Millisecond):
+	case <-time.After(5 * time.Second):
+		t.Fatal("After() did not deliver")
+	}
+
+	done := make(chan struct{})
+	timer := c.AfterFunc(time.Millisecond, func() { close(done) })
+	if timer.C() != nil {
+		t.Error("AfterFunc timer C() is not nil")
+	}
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("AfterFunc() did not run")
+	}
+}
+
+func TestClockContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := ClockFrom(ctx).(realClock); !ok {
+		t.Errorf("ClockFrom(empty ctx) = %T, want realClock", ClockFrom(ctx))
+	}
+	if _, ok := ClockFrom(WithClock(ctx, nil)).(realClock); !ok {
+		t.Error("ClockFrom() with a nil Clock did not fall back to RealClock")
+	}
+
+	start := New(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+	fake := NewFakeClock(start)
+	ctx = WithClock(ctx, fake)
+	if ClockFrom(ctx) != Clock(fake) {
+		t.Error("ClockFrom() did not return the injected clock")
+	}
+	if got := NowCtx(ctx); !got.Equal(start) {
+		t.Errorf("NowCtx() = %v, want %v", got, start)
+	}
+	fake.Advance(time.Minute)
+	if got := NowCtx(ctx); !got.Equal(start.Add(time.Minute)) {
+		t.Errorf("NowCtx() after Advance = %v", got)
+	}
+}
@@ -0,0 +1,29 @@
+package utc
+
+// MarshalCSV returns t as a CSV field. It implements the field marshaler
+// interface of github.com/gocarina/gocsv and similar libraries, and can be
+// called directly when building encoding/csv records. The zero Time is an
+// empty field, which UnmarshalCSV and UnmarshalText read back as the zero
+// Time; other values have the same form as MarshalText.
+func (t Time) MarshalCSV() (string, error) {
+	if t.utc().IsZero() {
+		return "", nil
+	}
+	text, err := t.MarshalText()
+	if err != nil {
+		return "", err
+	}
+	return string(text), nil
+}
+
+// UnmarshalCSV parses a CSV field into t like UnmarshalText. It implements
+// the field unmarshaler interface of github.com/gocarina/gocsv and similar
+// libraries. An empty field decodes as the zero Time.
+func (t *Time) UnmarshalCSV(field string) error {
+	if t == nil {
+		debugLog("UnmarshalCSV() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal CSV into nil utc.Time")
+	}
+	p := currentParser()
+	return p.decodeText(t, []byte(field))
+}
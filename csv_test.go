@@ -0,0 +1,75 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_CSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		t     Time
+		field string
+	}{
+		{"zero", Time{}, ""},
+		{"nanoseconds", New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)), "2024-01-02T03:04:05.123456789Z"},
+		{"infinity", NegativeInfinity, "-infinity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			field, err := tt.t.MarshalCSV()
+			if err != nil || field != tt.field {
+				t.Fatalf("MarshalCSV() = %q, %v, want %q", field, err, tt.field)
+			}
+			got := Now()
+			if err := got.UnmarshalCSV(field); err != nil || !got.Equal(tt.t) {
+				t.Errorf("UnmarshalCSV(%q) = %v, %v, want %v", field, got, err, tt.t)
+			}
+		})
+	}
+
+	var got Time
+	if err := got.UnmarshalCSV("2024-01-02 03:04:05"); err != nil || got != New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)) {
+		t.Errorf("UnmarshalCSV() = %v, %v", got, err)
+	}
+	if err := got.UnmarshalCSV("not a time"); err == nil {
+		t.Error("UnmarshalCSV() of an invalid field succeeded")
+	}
+	var nilTime *Time
+	if err := nilTime.UnmarshalCSV(""); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalCSV() error = %v, want ErrNilReceiver", err)
+	}
+}
+
+func TestTime_CSVRecords(t *testing.T) {
+	rows := []Time{Unix(1700000000, 0), {}}
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	for _, ut := range rows {
+		field, err := ut.MarshalCSV()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := w.Write([]string{"event", field}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w.Flush()
+	if want := "event,2023-11-14T22:13:20Z\nevent,\n"; buf.String() != want {
+		t.Errorf("csv output = %q, want %q", buf.String(), want)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, record := range records {
+		var got Time
+		if err := got.UnmarshalCSV(record[1]); err != nil || got != rows[i] {
+			t.Errorf("row %d = %v, %v, want %v", i, got, err, rows[i])
+		}
+	}
+}
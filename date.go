@@ -0,0 +1,271 @@
+package utc
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Date stores a calendar date (year, month, day) without a clock or location.
+//
+// The zero value is January 1, year 1, matching the zero value of Time.
+type Date struct {
+	t time.Time
+}
+
+// NewDate returns the Date for the given year, month, and day.
+// Out-of-range values are normalized the same way time.Date normalizes them.
+func NewDate(year int, month time.Month, day int) Date {
+	return Date{t: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+// DateOf returns the UTC calendar date of t.
+func DateOf(t Time) Date {
+	y, m, d := t.utc().Date()
+	return NewDate(y, m, d)
+}
+
+// DateFrom returns the UTC calendar date of any value that exposes a UTC time.Time.
+func DateFrom(t UTC) Date {
+	return DateOf(From(t))
+}
+
+// Today returns the current UTC calendar date.
+func Today() Date {
+	return DateOf(Now())
+}
+
+// ParseDate parses a date string in TimeLayoutDateOnly format ("2006-01-02").
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(string(TimeLayoutDateOnly), s)
+	if err != nil {
+		return Date{}, err
+	}
+	return NewDate(t.Date()), nil
+}
+
+// Date returns the year, month, and day of d.
+func (d Date) Date() (year int, month time.Month, day int) {
+	return d.t.Date()
+}
+
+// Year returns the year of d.
+func (d Date) Year() int {
+	return d.t.Year()
+}
+
+// Month returns the month of d.
+func (d Date) Month() time.Month {
+	return d.t.Month()
+}
+
+// Day returns the day of the month of d.
+func (d Date) Day() int {
+	return d.t.Day()
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return d.t.Weekday()
+}
+
+// YearDay returns the day of the year of d, in the range [1,365] for
+// non-leap years and [1,366] in leap years.
+func (d Date) YearDay() int {
+	return d.t.YearDay()
+}
+
+// Time returns the instant at the start of d in UTC.
+func (d Date) Time() Time {
+	return New(d.t)
+}
+
+// In returns the instant at the start of d in loc. A nil loc is treated as UTC.
+func (d Date) In(loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, day := d.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, loc)
+}
+
+// AddDays returns the date n days after d. Negative n moves backwards.
+func (d Date) AddDays(n int) Date {
+	return Date{t: d.t.AddDate(0, 0, n)}
+}
+
+// AddDate returns the date corresponding to adding the given number of years,
+// months, and days to d. It normalizes overflow the same way time.Time.AddDate does.
+func (d Date) AddDate(years, months, days int) Date {
+	return Date{t: d.t.AddDate(years, months, days)}
+}
+
+// DaysSince returns the number of days from u to d. Unlike time.Time.Sub, it
+// does not saturate for dates more than about 292 years apart.
+func (d Date) DaysSince(u Date) int {
+	return int((d.t.Unix() - u.t.Unix()) / 86400)
+}
+
+// Before reports whether d is before u
+func (d Date) Before(u Date) bool {
+	return d.t.Before(u.t)
+}
+
+// After reports whether d is after u
+func (d Date) After(u Date) bool {
+	return d.t.After(u.t)
+}
+
+// Equal reports whether d and u are the same date
+func (d Date) Equal(u Date) bool {
+	return d.t.Equal(u.t)
+}
+
+// IsZero reports whether d is the zero date, January 1, year 1.
+func (d Date) IsZero() bool {
+	return d.t.IsZero()
+}
+
+// String implements fmt.Stringer. It prints the date in TimeLayoutDateOnly format.
+func (d Date) String() string {
+	return d.t.Format(string(TimeLayoutDateOnly))
+}
+
+// Format formats the date using the specified layout
+func (d Date) Format(layout string) string {
+	return d.t.Format(layout)
+}
+
+// MarshalJSON implements the json.Marshaler interface for Date.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if d == nil {
+		debugLog("UnmarshalJSON() called on nil *Date receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.Date")
+	}
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty data into utc.Date")
+	}
+
+	if string(data) == "null" {
+		d.t = time.Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("utc.Date must be a JSON string or null: %w", err)
+	}
+
+	return d.set(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Date) UnmarshalText(text []byte) error {
+	if d == nil {
+		debugLog("UnmarshalText() called on nil *Date receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.Date")
+	}
+	return d.set(string(text))
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Date.
+func (d *Date) UnmarshalYAML(unmarshal func(any) error) error {
+	if d == nil {
+		debugLog("UnmarshalYAML() called on nil *Date receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.Date")
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	if err := d.set(s); err != nil {
+		return fmt.Errorf("failed to parse date %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for Date.
+func (d Date) MarshalYAML() (any, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.String(), nil
+}
+
+// Value implements driver.Valuer for database operations.
+// It returns the date as a "2006-01-02" string so DATE columns do not receive
+// a midnight instant that drivers may shift into a session time zone.
+func (d Date) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// Scan implements sql.Scanner for database operations.
+// It accepts time.Time, string, and []byte values. For time.Time values the
+// calendar date is taken in the value's own location, since drivers commonly
+// return DATE columns as midnight in the session time zone.
+func (d *Date) Scan(value any) error {
+	if d == nil {
+		debugLog("Scan() called on nil *Date receiver")
+		return newError(ErrNilReceiver, "cannot scan into nil utc.Date")
+	}
+
+	if value == nil {
+		return newError(ErrNil, "cannot scan nil into utc.Date")
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		if New(v).IsInfinite() {
+			return newError(ErrOutOfRange, "cannot scan an infinite time into utc.Date")
+		}
+		*d = NewDate(v.Date())
+		return nil
+	case string:
+		return d.set(v)
+	case []byte:
+		return d.set(string(v))
+	default:
+		return newError(ErrUnsupportedType, "cannot scan non-date value into utc.Date")
+	}
+}
+
+// set parses s into d. An empty string sets the zero date. Inputs that are not
+// in TimeLayoutDateOnly format fall back to the flexible timestamp layouts so
+// DATETIME-style strings keep their UTC calendar date. A Date has no infinity
+// or epoch form, so those inputs are rejected even when the default parser
+// accepts them.
+func (d *Date) set(s string) error {
+	if s == "" {
+		d.t = time.Time{}
+		return nil
+	}
+	parsed, err := ParseDate(s)
+	if err == nil {
+		*d = parsed
+		return nil
+	}
+	p := currentParser()
+	p.Epoch = EpochNone
+	t, perr := p.parse(s)
+	if perr != nil || New(t).IsInfinite() {
+		return err
+	}
+	*d = NewDate(t.Date())
+	return nil
+}
@@ -0,0 +1,259 @@
+package utc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDate_Constructors(t *testing.T) {
+	ut := New(time.Date(2024, 3, 10, 23, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+	got := DateOf(ut)
+	if y, m, d := got.Date(); y != 2024 || m != time.March || d != 11 {
+		t.Errorf("DateOf() = %v, want 2024-03-11 (UTC calendar date)", got)
+	}
+	if !DateFrom(ut.UTC()).Equal(got) {
+		t.Errorf("DateFrom() = %v, want %v", DateFrom(ut.UTC()), got)
+	}
+	if normalized := NewDate(2024, 2, 30); normalized.String() != "2024-03-01" {
+		t.Errorf("NewDate(2024, 2, 30) = %v, want 2024-03-01", normalized)
+	}
+	if !Today().Equal(DateOf(Now())) {
+		t.Errorf("Today() = %v, want %v", Today(), DateOf(Now()))
+	}
+}
+
+func TestDate_ParseDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    Date
+		wantErr bool
+	}{
+		{name: "date only", input: "2024-02-29", want: NewDate(2024, 2, 29)},
+		{name: "invalid day", input: "2023-02-29", wantErr: true},
+		{name: "timestamp", input: "2024-02-29T12:00:00Z", wantErr: true},
+		{name: "garbage", input: "not-a-date", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("ParseDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_Accessors(t *testing.T) {
+	d := NewDate(2024, 12, 31)
+	if d.Year() != 2024 || d.Month() != time.December || d.Day() != 31 {
+		t.Errorf("accessors = %d-%d-%d", d.Year(), d.Month(), d.Day())
+	}
+	if d.Weekday() != time.Tuesday {
+		t.Errorf("Weekday() = %v, want Tuesday", d.Weekday())
+	}
+	if d.YearDay() != 366 {
+		t.Errorf("YearDay() = %d, want 366", d.YearDay())
+	}
+	if got := d.Time().UTC(); !got.Equal(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Time() = %v", got)
+	}
+	if got := d.In(easternLocation); got.Hour() != 0 || got.Day() != 31 || got.Location() != easternLocation {
+		t.Errorf("In(Eastern) = %v, want local midnight", got)
+	}
+	if got := d.In(nil); got.Location() != time.UTC {
+		t.Errorf("In(nil) location = %v, want UTC", got.Location())
+	}
+	if got := d.Format(string(TimeLayoutUSDateShort)); got != "12/31/2024" {
+		t.Errorf("Format() = %q", got)
+	}
+}
+
+func TestDate_Arithmetic(t *testing.T) {
+	d := NewDate(2024, 2, 28)
+	if got := d.AddDays(2); got.String() != "2024-03-01" {
+		t.Errorf("AddDays(2) = %v", got)
+	}
+	if got := d.AddDays(-59); got.String() != "2023-12-31" {
+		t.Errorf("AddDays(-59) = %v", got)
+	}
+	if got := d.AddDate(1, 1, 0); got.String() != "2025-03-28" {
+		t.Errorf("AddDate(1, 1, 0) = %v", got)
+	}
+	if got := NewDate(2024, 3, 1).DaysSince(d); got != 2 {
+		t.Errorf("DaysSince() = %d, want 2", got)
+	}
+	if got := NewDate(2000, 1, 1).DaysSince(Date{}); got != 730119 {
+		t.Errorf("DaysSince(zero) = %d, want 730119", got)
+	}
+	if got := (Date{}).DaysSince(NewDate(9999, 12, 31)); got != -3652058 {
+		t.Errorf("DaysSince(9999-12-31) = %d, want -3652058", got)
+	}
+	if !d.Before(d.AddDays(1)) || !d.AddDays(1).After(d) || d.Equal(d.AddDays(1)) {
+		t.Error("comparisons failed")
+	}
+	var zero Date
+	if !zero.IsZero() || d.IsZero() {
+		t.Error("IsZero() failed")
+	}
+}
+
+func TestDate_JSON(t *testing.T) {
+	type record struct {
+		Birthday Date `json:"birthday"`
+	}
+	data, err := json.Marshal(record{Birthday: NewDate(1990, 7, 4)})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `{"birthday":"1990-07-04"}` {
+		t.Errorf("json.Marshal() = %s", data)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    Date
+		wantErr bool
+	}{
+		{name: "date", input: `"1990-07-04"`, want: NewDate(1990, 7, 4)},
+		{name: "timestamp keeps UTC date", input: `"1990-07-04T23:00:00-05:00"`, want: NewDate(1990, 7, 5)},
+		{name: "null", input: `null`, want: Date{}},
+		{name: "empty string", input: `""`, want: Date{}},
+		{name: "empty data", input: ``, wantErr: true},
+		{name: "number", input: `19900704`, wantErr: true},
+		{name: "invalid", input: `"1990-13-04"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewDate(2000, 1, 1)
+			err := got.UnmarshalJSON([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_TextAndYAML(t *testing.T) {
+	d := NewDate(2024, 1, 2)
+	text, err := d.MarshalText()
+	if err != nil || string(text) != "2024-01-02" {
+		t.Fatalf("MarshalText() = %q, %v", text, err)
+	}
+	var decoded Date
+	if err := decoded.UnmarshalText(text); err != nil || !decoded.Equal(d) {
+		t.Fatalf("UnmarshalText() = %v, %v", decoded, err)
+	}
+	if err := decoded.UnmarshalText(nil); err != nil || !decoded.IsZero() {
+		t.Fatalf("UnmarshalText(empty) = %v, %v", decoded, err)
+	}
+
+	marshaled, err := d.MarshalYAML()
+	if err != nil || marshaled != "2024-01-02" {
+		t.Fatalf("MarshalYAML() = %#v, %v", marshaled, err)
+	}
+	if marshaled, _ := (Date{}).MarshalYAML(); marshaled != nil {
+		t.Errorf("MarshalYAML(zero) = %#v, want nil", marshaled)
+	}
+	err = decoded.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "2024-01-02"
+		return nil
+	})
+	if err != nil || !decoded.Equal(d) {
+		t.Fatalf("UnmarshalYAML() = %v, %v", decoded, err)
+	}
+	err = decoded.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "not-a-date"
+		return nil
+	})
+	if err == nil {
+		t.Error("UnmarshalYAML(invalid) should return error")
+	}
+}
+
+func TestDate_DatabaseOperations(t *testing.T) {
+	d := NewDate(2024, 1, 2)
+	value, err := d.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if value != "2024-01-02" {
+		t.Errorf("Value() = %#v, want \"2024-01-02\"", value)
+	}
+
+	tests := []struct {
+		name    string
+		input   any
+		want    Date
+		wantErr bool
+	}{
+		{name: "value round trip", input: value, want: d},
+		{name: "bytes", input: []byte("2024-01-02"), want: d},
+		{name: "datetime string", input: "2024-01-02 00:00:00", want: d},
+		{name: "local midnight", input: time.Date(2024, 1, 2, 0, 0, 0, 0, pacificLocation), want: d},
+		{name: "nil", input: nil, wantErr: true},
+		{name: "unsupported", input: 42, wantErr: true},
+		{name: "invalid", input: "nope", wantErr: true},
+		{name: "infinity", input: "infinity", wantErr: true},
+		{name: "negative infinity", input: []byte("-infinity"), wantErr: true},
+		{name: "infinite time", input: PositiveInfinity.Time(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Date
+			err := got.Scan(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDate_RejectsEpochs(t *testing.T) {
+	t.Cleanup(func() { SetDefaultParser(nil) })
+	SetDefaultParser(&Parser{Epoch: EpochAuto})
+
+	var d Date
+	for _, input := range []string{`"1704164645"`, `1704164645`, `"infinity"`} {
+		if err := json.Unmarshal([]byte(input), &d); err == nil {
+			t.Errorf("json.Unmarshal(%s) = %v, want error", input, d)
+		}
+	}
+	if err := d.UnmarshalText([]byte("1704164645000")); err == nil {
+		t.Errorf("UnmarshalText(epoch) = %v, want error", d)
+	}
+	if err := d.Scan("1704164645"); err == nil {
+		t.Errorf("Scan(epoch) = %v, want error", d)
+	}
+	// Timestamp layouts still fall back to their calendar date.
+	if err := d.Scan("2024-01-02T03:04:05Z"); err != nil || !d.Equal(NewDate(2024, 1, 2)) {
+		t.Errorf("Scan(timestamp) = %v, %v", d, err)
+	}
+}
+
+func TestDate_NilHandling(t *testing.T) {
+	var d *Date
+	if err := d.UnmarshalJSON([]byte(`"2024-01-02"`)); err == nil {
+		t.Error("UnmarshalJSON() on nil receiver should return error")
+	}
+	if err := d.UnmarshalText([]byte("2024-01-02")); err == nil {
+		t.Error("UnmarshalText() on nil receiver should return error")
+	}
+	if err := d.UnmarshalYAML(func(any) error { return nil }); err == nil {
+		t.Error("UnmarshalYAML() on nil receiver should return error")
+	}
+	if err := d.Scan("2024-01-02"); err == nil {
+		t.Error("Scan() on nil receiver should return error")
+	}
+}
@@ -15,9 +15,17 @@ var (
 	debugOnce   sync.Once
 )
 
+// stderr writes to the current os.Stderr, so redirecting os.Stderr after the
+// logger is initialized still captures debug output.
+type stderr struct{}
+
+func (stderr) Write(p []byte) (int, error) {
+	return os.Stderr.Write(p)
+}
+
 func initDebugLogger() {
 	debugOnce.Do(func() {
-		debugLogger = log.New(os.Stderr, "[UTC DEBUG] ", log.Ldate|log.Ltime|log.Lshortfile)
+		debugLogger = log.New(stderr{}, "[UTC DEBUG] ", log.Ldate|log.Ltime|log.Lshortfile)
 	})
 }
 
@@ -0,0 +1,228 @@
+package utc
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Dialect describes how a database driver represents timestamps, for columns
+// whose values the generic Time.Scan does not understand.
+//
+// Wrap a scan destination or query argument with Dialect.Time or
+// Dialect.NullTime:
+//
+//	var created utc.Time
+//	var deleted utc.NullTime
+//	err := row.Scan(utc.DialectMySQL.Time(&created), utc.DialectMySQL.NullTime(&deleted))
+type Dialect int
+
+// Supported dialects.
+const (
+	// DialectGeneric scans like Time.Scan and writes like Time.Value.
+	DialectGeneric Dialect = iota
+	// DialectPostgres accepts PostgreSQL text output such as
+	// "2024-01-02 03:04:05.123456+00". The special values "infinity" and
+	// "-infinity" scan as PositiveInfinity and NegativeInfinity and are
+	// written back the same way.
+	DialectPostgres
+	// DialectMySQL accepts MySQL DATETIME and TIMESTAMP text. The zero dates
+	// "0000-00-00" and "0000-00-00 00:00:00" scan as the zero Time, or as
+	// null into a NullTime, and the zero Time is written as
+	// "0000-00-00 00:00:00". Servers in the NO_ZERO_DATE SQL mode reject
+	// that value; use NullTime for optional columns there.
+	DialectMySQL
+	// DialectSQLite accepts the text formats of SQLite's date and time
+	// functions, INTEGER Unix seconds, and REAL Julian day numbers, and
+	// writes text in the "2006-01-02 15:04:05.999999999" form those
+	// functions read.
+	DialectSQLite
+	// DialectSQLServer accepts SQL Server datetime2 and datetimeoffset text
+	// such as "2024-01-02 03:04:05.1234567 +00:00".
+	//
+	// MySQL and SQL Server have no infinite timestamps, so writing
+	// PositiveInfinity or NegativeInfinity in those dialects fails with
+	// ErrOutOfRange. SQLite stores them as text.
+	DialectSQLServer
+)
+
+var dialectNames = [...]string{
+	DialectGeneric:   "Generic",
+	DialectPostgres:  "Postgres",
+	DialectMySQL:     "MySQL",
+	DialectSQLite:    "SQLite",
+	DialectSQLServer: "SQLServer",
+}
+
+// String implements fmt.Stringer.
+func (d Dialect) String() string {
+	if d >= 0 && int(d) < len(dialectNames) {
+		return dialectNames[d]
+	}
+	return fmt.Sprintf("Dialect(%d)", int(d))
+}
+
+// Layouts tried for each dialect's text values, in order. Zone-less layouts
+// follow the default parser's Location and Zoneless policy.
+var dialectLayouts = map[Dialect][]string{
+	DialectPostgres: {
+		"2006-01-02 15:04:05.999999999-07",
+		"2006-01-02 15:04:05.999999999-07:00",
+		"2006-01-02 15:04:05.999999999-07:00:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+	},
+	DialectMySQL: {
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02",
+	},
+	DialectSQLite: {
+		"2006-01-02 15:04:05.999999999Z07:00",
+		"2006-01-02T15:04:05.999999999Z07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02 15:04",
+		"2006-01-02T15:04",
+		"2006-01-02",
+	},
+	DialectSQLServer: {
+		"2006-01-02 15:04:05.999999999 -07:00",
+		"2006-01-02T15:04:05.999999999-07:00",
+		"2006-01-02 15:04:05.999999999",
+		"2006-01-02T15:04:05.999999999",
+		"2006-01-02",
+	},
+}
+
+// Formats written by DialectMySQL and DialectSQLite.
+const (
+	mysqlZeroDate = "0000-00-00 00:00:00"
+	sqliteLayout  = "2006-01-02 15:04:05.999999999"
+)
+
+// unixEpochJulianDay is the Julian day number of the Unix epoch.
+const unixEpochJulianDay = 2440587.5
+
+// Time returns a DialectValue that scans into and writes *t.
+func (d Dialect) Time(t *Time) *DialectValue {
+	return &DialectValue{dialect: d, t: t}
+}
+
+// NullTime returns a DialectValue that scans into and writes *n.
+func (d Dialect) NullTime(n *NullTime) *DialectValue {
+	return &DialectValue{dialect: d, n: n}
+}
+
+// DialectValue adapts a Time or NullTime to a database dialect. It implements
+// sql.Scanner and driver.Valuer. Create one with Dialect.Time or
+// Dialect.NullTime.
+type DialectValue struct {
+	dialect Dialect
+	t       *Time
+	n       *NullTime
+}
+
+// Scan implements sql.Scanner.
+func (v *DialectValue) Scan(value any) error {
+	if v == nil || (v.t == nil && v.n == nil) {
+		debugLog("Scan() called on nil *DialectValue target")
+		return newError(ErrNilReceiver, "cannot scan into nil utc.DialectValue")
+	}
+
+	parsed, null, err := v.dialect.scan(value)
+	if err != nil {
+		return err
+	}
+	if v.n != nil {
+		if null {
+			*v.n = NullTime{}
+		} else {
+			*v.n = NullTimeFrom(New(parsed))
+		}
+		return nil
+	}
+	if value == nil {
+		return newError(ErrNil, "cannot scan nil into utc.Time")
+	}
+	v.t.t = parsed
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (v *DialectValue) Value() (driver.Value, error) {
+	if v == nil || (v.t == nil && v.n == nil) {
+		debugLog("Value() called on nil *DialectValue target")
+		return nil, newError(ErrNilReceiver, "cannot take value of nil utc.DialectValue")
+	}
+	t := v.t
+	if v.n != nil {
+		if !v.n.Valid {
+			return nil, nil
+		}
+		t = &v.n.Time
+	}
+
+	switch v.dialect {
+	case DialectMySQL, DialectSQLServer:
+		if t.IsInfinite() {
+			return nil, fmt.Errorf("cannot write %s utc.Time to %s: %w", t, v.dialect, ErrOutOfRange)
+		}
+		if v.dialect == DialectMySQL && t.IsZero() {
+			return mysqlZeroDate, nil
+		}
+	case DialectSQLite:
+		if inf, ok := t.infinityString(); ok {
+			return inf, nil
+		}
+		return t.utc().Truncate(time.Duration(ValuePrecision())).Format(sqliteLayout), nil
+	}
+	return t.Value()
+}
+
+// scan converts a driver value. null is true for SQL NULL and, in MySQL, for
+// zero dates; t is then the zero time.
+func (d Dialect) scan(value any) (t time.Time, null bool, err error) {
+	if value == nil {
+		return time.Time{}, true, nil
+	}
+	if b, ok := value.([]byte); ok {
+		value = string(b)
+	}
+
+	p := currentParser()
+	switch v := value.(type) {
+	case string:
+		if d == DialectGeneric {
+			break
+		}
+		s := strings.TrimSpace(v)
+		if d == DialectMySQL && strings.HasPrefix(s, "0000-00-00") {
+			return time.Time{}, true, nil
+		}
+		p.Layouts = dialectLayouts[d]
+		p.Mode = ParseStrict
+		parsed, err := p.parse(s)
+		return parsed, false, err
+	case int64:
+		if d == DialectSQLite {
+			return time.Unix(v, 0).UTC(), false, nil
+		}
+	case float64:
+		if d == DialectSQLite {
+			// SQLite keeps Julian days to the millisecond.
+			ms := math.Round((v - unixEpochJulianDay) * 86400e3)
+			if math.IsNaN(ms) || math.Abs(ms) > 1<<62 {
+				return time.Time{}, false, fmt.Errorf("cannot scan Julian day %v into utc.Time: %w", v, ErrOutOfRange)
+			}
+			return time.UnixMilli(int64(ms)).UTC(), false, nil
+		}
+	}
+
+	var scanned Time
+	if err := p.decodeSQL(&scanned, value); err != nil {
+		return time.Time{}, false, err
+	}
+	return scanned.t, false, nil
+}
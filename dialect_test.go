@@ -0,0 +1,212 @@
+package utc
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+// echoDriver is a database/sql driver whose queries return a single row
+// holding the query arguments, so values make a full trip through
+// database/sql conversion.
+type echoDriver struct{}
+
+func (echoDriver) Open(string) (driver.Conn, error) { return echoConn{}, nil }
+
+type echoConn struct{}
+
+func (echoConn) Prepare(string) (driver.Stmt, error) { return echoStmt{}, nil }
+func (echoConn) Close() error                        { return nil }
+func (echoConn) Begin() (driver.Tx, error)           { return nil, errors.New("transactions not supported") }
+
+type echoStmt struct{}
+
+func (echoStmt) Close() error  { return nil }
+func (echoStmt) NumInput() int { return -1 }
+func (echoStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("exec not supported")
+}
+func (echoStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return &echoRows{values: args}, nil
+}
+
+type echoRows struct {
+	values []driver.Value
+	done   bool
+}
+
+func (r *echoRows) Columns() []string {
+	cols := make([]string, len(r.values))
+	for k := range cols {
+		cols[k] = "c"
+	}
+	return cols
+}
+func (r *echoRows) Close() error { return nil }
+func (r *echoRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.values)
+	return nil
+}
+
+func init() {
+	sql.Register("utc-echo", echoDriver{})
+}
+
+func openEcho(t *testing.T) *sql.DB {
+	t.Helper()
+	db, err := sql.Open("utc-echo", "")
+	if err != nil {
+		t.Fatalf("sql.Open() error = %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestDialect_Scan(t *testing.T) {
+	db := openEcho(t)
+	base := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		dialect Dialect
+		value   any
+		want    time.Time
+		wantErr error
+	}{
+		{"postgres timestamptz", DialectPostgres, "2024-01-02 03:04:05.123456+00", base.Add(123456 * time.Microsecond), nil},
+		{"postgres half-hour offset", DialectPostgres, []byte("2024-01-02 08:34:05+05:30"), base, nil},
+		{"postgres timestamp", DialectPostgres, "2024-01-02 03:04:05", base, nil},
+		{"postgres time.Time", DialectPostgres, base.In(easternLocation), base, nil},
+		{"postgres infinity", DialectPostgres, "infinity", PositiveInfinity.UTC(), nil},
+		{"postgres -infinity", DialectPostgres, []byte("-infinity"), NegativeInfinity.UTC(), nil},
+		{"mysql datetime", DialectMySQL, []byte("2024-01-02 03:04:05.123"), base.Add(123 * time.Millisecond), nil},
+		{"mysql date", DialectMySQL, "2024-01-02", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), nil},
+		{"mysql zero datetime", DialectMySQL, []byte("0000-00-00 00:00:00"), time.Time{}, nil},
+		{"mysql zero date", DialectMySQL, "0000-00-00", time.Time{}, nil},
+		{"sqlite text", DialectSQLite, "2024-01-02 03:04:05.5", base.Add(500 * time.Millisecond), nil},
+		{"sqlite text with zone", DialectSQLite, "2024-01-02T03:04:05Z", base, nil},
+		{"sqlite minutes", DialectSQLite, "2024-01-02 03:04", base.Add(-5 * time.Second), nil},
+		{"sqlite unix", DialectSQLite, int64(1704164645), base, nil},
+		{"sqlite julian day", DialectSQLite, 2460311.627835648, base, nil},
+		{"sqlite bad julian day", DialectSQLite, 1e300, time.Time{}, ErrOutOfRange},
+		{"sqlserver datetimeoffset", DialectSQLServer, "2024-01-01 22:04:05.1234567 -05:00", base.Add(123456700), nil},
+		{"sqlserver datetime2", DialectSQLServer, "2024-01-02 03:04:05.1234567", base.Add(123456700), nil},
+		{"generic", DialectGeneric, "2024-01-02T03:04:05Z", base, nil},
+		{"generic int", DialectGeneric, int64(1704164645), time.Time{}, ErrUnsupportedType},
+		{"unparseable", DialectMySQL, "yesterday", time.Time{}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Time
+			err := db.QueryRow("echo", tt.value).Scan(tt.dialect.Time(&got))
+			if tt.name == "unparseable" {
+				var perr *ParseError
+				if !errors.As(err, &perr) {
+					t.Fatalf("Scan() error = %v, want *ParseError", err)
+				}
+				return
+			}
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Scan() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Scan() error = %v", err)
+			}
+			if !got.UTC().Equal(tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDialect_ScanNull(t *testing.T) {
+	db := openEcho(t)
+
+	n := NullTimeFrom(Now())
+	if err := db.QueryRow("echo", "0000-00-00 00:00:00").Scan(DialectMySQL.NullTime(&n)); err != nil || n.Valid {
+		t.Errorf("Scan(zero date) into NullTime = %+v, %v", n, err)
+	}
+	n = NullTimeFrom(Now())
+	if err := db.QueryRow("echo", nil).Scan(DialectPostgres.NullTime(&n)); err != nil || n.Valid {
+		t.Errorf("Scan(NULL) into NullTime = %+v, %v", n, err)
+	}
+	if err := db.QueryRow("echo", "2024-01-02 03:04:05+00").Scan(DialectPostgres.NullTime(&n)); err != nil || !n.Valid {
+		t.Errorf("Scan() into NullTime = %+v, %v", n, err)
+	}
+
+	var ut Time
+	if err := db.QueryRow("echo", nil).Scan(DialectPostgres.Time(&ut)); !errors.Is(err, ErrNil) {
+		t.Errorf("Scan(NULL) into Time error = %v, want ErrNil", err)
+	}
+	if err := DialectSQLite.Time(nil).Scan("2024-01-02"); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("Scan() into nil target error = %v, want ErrNilReceiver", err)
+	}
+}
+
+func TestDialect_Value(t *testing.T) {
+	db := openEcho(t)
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC))
+
+	tests := []struct {
+		name    string
+		dialect Dialect
+		value   Time
+		want    any
+	}{
+		{"postgres", DialectPostgres, ut, ut.UTC()},
+		{"mysql", DialectMySQL, ut, ut.UTC()},
+		{"mysql zero", DialectMySQL, Time{}, "0000-00-00 00:00:00"},
+		{"sqlite", DialectSQLite, ut, "2024-01-02 03:04:05.123456789"},
+		{"sqlite whole seconds", DialectSQLite, ut.TruncateTo(Second), "2024-01-02 03:04:05"},
+		{"sqlserver", DialectSQLServer, ut, ut.UTC()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value := tt.value
+			got, err := tt.dialect.Time(&value).Value()
+			if err != nil || got != tt.want {
+				t.Fatalf("Value() = %#v, %v; want %#v", got, err, tt.want)
+			}
+
+			// Values written by a dialect scan back unchanged.
+			var scanned Time
+			if err := db.QueryRow("echo", tt.dialect.Time(&value)).Scan(tt.dialect.Time(&scanned)); err != nil {
+				t.Fatalf("round trip error = %v", err)
+			}
+			if !scanned.Equal(value) {
+				t.Errorf("round trip = %v, want %v", scanned, value)
+			}
+		})
+	}
+
+	var null NullTime
+	if got, err := DialectMySQL.NullTime(&null).Value(); err != nil || got != nil {
+		t.Errorf("Value(null) = %#v, %v", got, err)
+	}
+	valid := NullTimeFrom(ut)
+	if got, err := DialectSQLite.NullTime(&valid).Value(); err != nil || got != "2024-01-02 03:04:05.123456789" {
+		t.Errorf("Value(valid NullTime) = %#v, %v", got, err)
+	}
+	if _, err := DialectMySQL.Time(nil).Value(); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("Value() of nil target error = %v, want ErrNilReceiver", err)
+	}
+}
+
+func TestDialect_String(t *testing.T) {
+	if got := DialectSQLServer.String(); got != "SQLServer" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := Dialect(9).String(); got != "Dialect(9)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,133 @@
+package utc
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EpochUnit selects whether and how a Parser accepts numeric Unix timestamps.
+type EpochUnit int
+
+// Epoch units.
+const (
+	// EpochNone rejects numeric input. It is the default.
+	EpochNone EpochUnit = iota
+	// EpochAuto infers the unit from the magnitude of the value: below 1e11
+	// is seconds, below 1e14 milliseconds, below 1e17 microseconds, and
+	// anything larger nanoseconds. Seconds therefore cover the years 1966
+	// through 5138 and the other units cover at least 1973 through 5138.
+	EpochAuto
+	// EpochSeconds reads numbers as seconds since the Unix epoch.
+	EpochSeconds
+	// EpochMillis reads numbers as milliseconds since the Unix epoch.
+	EpochMillis
+	// EpochMicros reads numbers as microseconds since the Unix epoch.
+	EpochMicros
+	// EpochNanos reads numbers as nanoseconds since the Unix epoch.
+	EpochNanos
+)
+
+var epochUnitNames = [...]string{
+	EpochNone:    "None",
+	EpochAuto:    "Auto",
+	EpochSeconds: "Seconds",
+	EpochMillis:  "Millis",
+	EpochMicros:  "Micros",
+	EpochNanos:   "Nanos",
+}
+
+// String implements fmt.Stringer.
+func (u EpochUnit) String() string {
+	if u >= 0 && int(u) < len(epochUnitNames) {
+		return epochUnitNames[u]
+	}
+	return fmt.Sprintf("EpochUnit(%d)", int(u))
+}
+
+// duration returns the length of one unit, inferring it from whole for EpochAuto.
+func (u EpochUnit) duration(whole int64) time.Duration {
+	switch u {
+	case EpochMillis:
+		return time.Millisecond
+	case EpochMicros:
+		return time.Microsecond
+	case EpochNanos:
+		return time.Nanosecond
+	case EpochAuto:
+		// Compare both signs rather than negating, which overflows for MinInt64.
+		switch {
+		case -1e11 < whole && whole < 1e11:
+			return time.Second
+		case -1e14 < whole && whole < 1e14:
+			return time.Millisecond
+		case -1e17 < whole && whole < 1e17:
+			return time.Microsecond
+		default:
+			return time.Nanosecond
+		}
+	default:
+		return time.Second
+	}
+}
+
+// isNumber reports whether s is a decimal number with an optional minus sign,
+// fraction, and exponent, as written by JSON, YAML, and strconv.FormatFloat.
+func isNumber(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	mantissa, exponent := s, ""
+	if k := strings.IndexAny(s, "eE"); k >= 0 {
+		mantissa, exponent = s[:k], s[k+1:]
+		if exponent = strings.TrimLeft(exponent, "+-"); !isInteger(exponent) {
+			return false
+		}
+	}
+	whole, fraction, hasFraction := strings.Cut(mantissa, ".")
+	return isInteger(whole) && !strings.HasPrefix(whole, "-") && (!hasFraction || isInteger(fraction) && !strings.HasPrefix(fraction, "-"))
+}
+
+// parseEpoch parses s, which must satisfy isNumber, as a Unix timestamp in
+// unit u. Fractions are kept to nanosecond precision.
+func parseEpoch(s string, u EpochUnit) (time.Time, error) {
+	if strings.ContainsAny(s, "eE") {
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot parse %q as a Unix timestamp: %w", s, err)
+		}
+		unit := u.duration(int64(math.Max(math.Min(f, math.MaxInt64), math.MinInt64)))
+		t, err := FromUnixFloatChecked(f * float64(unit) / float64(time.Second))
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot parse %q as a Unix timestamp: %w", s, err)
+		}
+		return t.utc(), nil
+	}
+
+	wholeDigits, fraction, _ := strings.Cut(s, ".")
+	whole, err := strconv.ParseInt(wholeDigits, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("timestamp %q is out of range for Unix time: %w", s, err)
+	}
+	unit := u.duration(whole)
+	t, err := fromUnitIn(whole, unit)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("cannot parse %q as a Unix timestamp: %w", s, err)
+	}
+	if fraction != "" {
+		ns, err := parseFraction(fraction)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("cannot parse %q as a Unix timestamp: %w", s, err)
+		}
+		// Scale the fraction of one unit to nanoseconds.
+		nsec := time.Duration(int64(ns) * int64(unit) / int64(time.Second))
+		if strings.HasPrefix(wholeDigits, "-") {
+			nsec = -nsec
+		}
+		t = t.Add(nsec)
+		if New(t).IsInfinite() {
+			return time.Time{}, fmt.Errorf("cannot parse %q as a Unix timestamp: %w", s, ErrOutOfRange)
+		}
+	}
+	return t, nil
+}
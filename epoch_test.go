@@ -0,0 +1,130 @@
+package utc
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParser_Epoch(t *testing.T) {
+	base := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name    string
+		unit    EpochUnit
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"auto seconds", EpochAuto, "1704164645", base, false},
+		{"auto millis", EpochAuto, "1704164645123", base.Add(123 * time.Millisecond), false},
+		{"auto micros", EpochAuto, "1704164645123456", base.Add(123456 * time.Microsecond), false},
+		{"auto nanos", EpochAuto, "1704164645123456789", base.Add(123456789), false},
+		{"auto fractional seconds", EpochAuto, "1704164645.25", base.Add(250 * time.Millisecond), false},
+		{"auto exponent", EpochAuto, "1.704164645e9", base, false},
+		{"auto negative", EpochAuto, "-1.5", time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC), false},
+		{"seconds", EpochSeconds, "1704164645", base, false},
+		{"explicit millis small", EpochMillis, "10000", time.Unix(10, 0).UTC(), false},
+		{"fractional millis", EpochMillis, "1704164645123.5", base.Add(123*time.Millisecond + 500*time.Microsecond), false},
+		{"micros", EpochMicros, "1704164645000000", base, false},
+		{"nanos", EpochNanos, "-1", time.Unix(0, -1).UTC(), false},
+		{"negative millis", EpochMillis, "-1500", time.Date(1969, 12, 31, 23, 59, 58, 500000000, time.UTC), false},
+		{"layout wins over epoch", EpochSeconds, "2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"overflow", EpochSeconds, "99999999999999999999", time.Time{}, true},
+		{"auto max", EpochAuto, "9223372036854775807", time.Unix(0, math.MaxInt64).UTC(), false},
+		{"auto min", EpochAuto, "-9223372036854775808", time.Unix(0, math.MinInt64).UTC(), false},
+		{"seconds max", EpochSeconds, "9223372036854775807", time.Time{}, true},
+		{"seconds min", EpochSeconds, "-9223372036854775808", time.Time{}, true},
+		{"seconds max fraction", EpochSeconds, "9223372036854775807.5", time.Time{}, true},
+		{"fraction reaches infinity", EpochSeconds, "9223371974719179007.999999999", time.Time{}, true},
+		{"millis max", EpochMillis, "9223372036854775807", time.UnixMilli(math.MaxInt64).UTC(), false},
+		{"millis min", EpochMillis, "-9223372036854775808", time.UnixMilli(math.MinInt64).UTC(), false},
+		{"micros max", EpochMicros, "9223372036854775807", time.UnixMicro(math.MaxInt64).UTC(), false},
+		{"micros min", EpochMicros, "-9223372036854775808", time.UnixMicro(math.MinInt64).UTC(), false},
+		{"nanos max", EpochNanos, "9223372036854775807", time.Unix(0, math.MaxInt64).UTC(), false},
+		{"nanos min", EpochNanos, "-9223372036854775808", time.Unix(0, math.MinInt64).UTC(), false},
+		{"not a number", EpochAuto, "12ab", time.Time{}, true},
+		{"disabled", EpochNone, "1704164645", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := Parser{Epoch: tt.unit}
+			got, err := p.Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.UTC().Equal(tt.want) {
+				t.Errorf("Parse(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParser_EpochOutOfRange(t *testing.T) {
+	p := Parser{Epoch: EpochSeconds}
+	for _, input := range []string{"9223372036854775807", "-9223372036854775808"} {
+		if _, err := p.Parse(input); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("Parse(%q) error = %v, want ErrOutOfRange", input, err)
+		}
+	}
+}
+
+func TestParser_EpochDecoding(t *testing.T) {
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	p := &Parser{Epoch: EpochAuto}
+
+	var ut Time
+	for _, input := range []string{`1704164645`, `1704164645000`, `"1704164645"`, `1.704164645e9`} {
+		ut = Time{}
+		if err := json.Unmarshal([]byte(input), p.Into(&ut)); err != nil || !ut.UTC().Equal(want) {
+			t.Errorf("json.Unmarshal(%s) = %v, %v", input, ut, err)
+		}
+	}
+	if err := json.Unmarshal([]byte(`true`), p.Into(&ut)); err == nil {
+		t.Error("json.Unmarshal(true) should return error")
+	}
+	if err := p.Into(&ut).UnmarshalText([]byte("1704164645")); err != nil || !ut.UTC().Equal(want) {
+		t.Errorf("UnmarshalText() = %v, %v", ut, err)
+	}
+	err := p.Into(&ut).UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "1704164645000"
+		return nil
+	})
+	if err != nil || !ut.UTC().Equal(want) {
+		t.Errorf("UnmarshalYAML() = %v, %v", ut, err)
+	}
+	for _, value := range []any{int64(1704164645), float64(1704164645), []byte("1704164645000")} {
+		ut = Time{}
+		if err := p.Into(&ut).Scan(value); err != nil || !ut.UTC().Equal(want) {
+			t.Errorf("Scan(%T) = %v, %v", value, ut, err)
+		}
+	}
+
+	// Numbers stay rejected unless the parser opts in.
+	if err := json.Unmarshal([]byte(`1704164645`), &ut); err == nil {
+		t.Error("Time.UnmarshalJSON(number) should return error by default")
+	}
+	if err := ut.Scan(int64(1704164645)); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("Time.Scan(int64) error = %v, want ErrUnsupportedType", err)
+	}
+
+	t.Cleanup(func() { SetDefaultParser(nil) })
+	SetDefaultParser(&Parser{Epoch: EpochSeconds})
+	if err := ut.Scan(int64(1704164645)); err != nil || !ut.UTC().Equal(want) {
+		t.Errorf("Time.Scan(int64) with default epoch parser = %v, %v", ut, err)
+	}
+	var n NullTime
+	if err := json.Unmarshal([]byte(`1704164645`), &n); err != nil || !n.Valid || !n.Time.UTC().Equal(want) {
+		t.Errorf("NullTime.UnmarshalJSON(number) = %+v, %v", n, err)
+	}
+}
+
+func TestEpochUnit_String(t *testing.T) {
+	if got := EpochMillis.String(); got != "Millis" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := EpochUnit(42).String(); got != "EpochUnit(42)" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,51 @@
+package utc
+
+import "errors"
+
+// Sentinel errors returned, possibly wrapped, by the decoding and scanning
+// methods of this package. Match them with errors.Is.
+var (
+	// ErrEmpty reports empty input where a value is required, such as empty
+	// JSON data.
+	ErrEmpty = errors.New("empty input")
+	// ErrNil reports a nil value where a non-nil value is required, such as
+	// scanning SQL NULL into a Time.
+	ErrNil = errors.New("nil value")
+	// ErrNilReceiver reports a method called on a nil pointer receiver.
+	ErrNilReceiver = errors.New("nil receiver")
+	// ErrUnsupportedType reports a value of a type that cannot be converted,
+	// such as scanning an int64 into a Time.
+	ErrUnsupportedType = errors.New("unsupported type")
+	// ErrOutOfRange reports a value outside the range of time.Time or of the
+	// requested representation, such as a Unix nanosecond count after 2262.
+	ErrOutOfRange = errors.New("out of range")
+
+	// ErrMissingZone reports zone-less input rejected by ZonelessReject.
+	ErrMissingZone = errors.New("missing time zone or offset")
+	// ErrAmbiguousTime reports a wall time that occurs twice in its location,
+	// as when clocks fall back at the end of daylight saving time.
+	ErrAmbiguousTime = errors.New("ambiguous wall time")
+	// ErrNonexistentTime reports a wall time skipped in its location, as when
+	// clocks spring forward at the start of daylight saving time.
+	ErrNonexistentTime = errors.New("nonexistent wall time")
+
+	// ErrDateOverflow reports a day of the month that does not exist in the
+	// target month of calendar arithmetic using OverflowError.
+	ErrDateOverflow = errors.New("day does not exist in the target month")
+)
+
+// kindError is an error with a descriptive message that matches one of the
+// sentinel errors with errors.Is.
+type kindError struct {
+	kind error
+	msg  string
+}
+
+func (e *kindError) Error() string { return e.msg }
+
+func (e *kindError) Unwrap() error { return e.kind }
+
+// newError returns an error with message msg that wraps kind.
+func newError(kind error, msg string) error {
+	return &kindError{kind: kind, msg: msg}
+}
@@ -0,0 +1,109 @@
+package utc
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	var nilTime *Time
+	var nilDate *Date
+	var nilNull *NullTime
+	var nilInterval *Interval
+	var nilPeriod *Period
+	var nilTOD *TimeOfDay
+	var ut Time
+	var d Date
+	var tod TimeOfDay
+
+	tests := []struct {
+		name    string
+		err     error
+		want    error
+		message string
+	}{
+		{"nil Time JSON", nilTime.UnmarshalJSON([]byte(`null`)), ErrNilReceiver, "cannot unmarshal into nil utc.Time"},
+		{"nil Time marshal", func() error { _, err := nilTime.MarshalJSON(); return err }(), ErrNilReceiver, "cannot marshal nil utc.Time"},
+		{"nil Time text", nilTime.UnmarshalText(nil), ErrNilReceiver, "cannot unmarshal text into nil utc.Time"},
+		{"nil Time scan", nilTime.Scan(nil), ErrNilReceiver, "cannot scan into nil utc.Time"},
+		{"nil Date", nilDate.Scan(nil), ErrNilReceiver, "cannot scan into nil utc.Date"},
+		{"nil NullTime", nilNull.UnmarshalText(nil), ErrNilReceiver, "cannot unmarshal text into nil utc.NullTime"},
+		{"nil Interval", nilInterval.UnmarshalJSON(nil), ErrNilReceiver, "cannot unmarshal into nil utc.Interval"},
+		{"nil Period", nilPeriod.UnmarshalText(nil), ErrNilReceiver, "cannot unmarshal text into nil utc.Period"},
+		{"nil TimeOfDay", nilTOD.Scan(nil), ErrNilReceiver, "cannot scan into nil utc.TimeOfDay"},
+		{"nil Decoder target", (&Parser{}).Into(nil).Scan(nil), ErrNilReceiver, "cannot scan into nil utc.Time"},
+		{"empty Time JSON", ut.UnmarshalJSON([]byte(" ")), ErrEmpty, "cannot unmarshal empty data into utc.Time"},
+		{"empty Date JSON", d.UnmarshalJSON(nil), ErrEmpty, "cannot unmarshal empty data into utc.Date"},
+		{"scan nil Time", ut.Scan(nil), ErrNil, "cannot scan nil into utc.Time"},
+		{"scan nil TimeOfDay", tod.Scan(nil), ErrNil, "cannot scan nil into utc.TimeOfDay"},
+		{"scan int Time", ut.Scan(42), ErrUnsupportedType, "cannot scan non-time value into utc.Time"},
+		{"scan int Date", d.Scan(42), ErrUnsupportedType, "cannot scan non-date value into utc.Date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !errors.Is(tt.err, tt.want) {
+				t.Errorf("error = %v, want errors.Is(%v)", tt.err, tt.want)
+			}
+			if tt.err.Error() != tt.message {
+				t.Errorf("Error() = %q, want %q", tt.err.Error(), tt.message)
+			}
+		})
+	}
+}
+
+func TestParseError_Offset(t *testing.T) {
+	tests := []struct {
+		name   string
+		parser Parser
+		input  string
+		want   int
+	}{
+		{"bad month", Parser{}, "2024-13-02T03:04:05Z", 7},
+		{"bad separator", Parser{}, "2024-01-02X03:04:05Z", 10},
+		{"trailing text", Parser{}, "2024-01-02T03:04:05Zjunk", 20},
+		{"garbage", Parser{}, "nope", 0},
+		{"lenient leading space", Parser{Mode: ParseLenient}, "  2024-01-02X03:04:05Z", 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.parser.Parse(tt.input)
+			var perr *ParseError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse(%q) error = %v, want *ParseError", tt.input, err)
+			}
+			if perr.Offset != tt.want {
+				t.Errorf("Offset = %d, want %d", perr.Offset, tt.want)
+			}
+			for _, a := range perr.Attempts {
+				if a.Offset > perr.Offset {
+					t.Errorf("attempt %q offset %d exceeds ParseError.Offset %d", a.Layout, a.Offset, perr.Offset)
+				}
+			}
+		})
+	}
+}
+
+func TestParseError_FromDecoding(t *testing.T) {
+	var ut Time
+	err := json.Unmarshal([]byte(`"2024-01-02 25:00:00"`), &ut)
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("json.Unmarshal() error = %T, want *ParseError", err)
+	}
+	if perr.Input != "2024-01-02 25:00:00" || len(perr.Attempts) != len(DefaultLayouts()) {
+		t.Errorf("ParseError = %+v", perr)
+	}
+	var terr *time.ParseError
+	if !errors.As(err, &terr) {
+		t.Error("ParseError should unwrap to *time.ParseError")
+	}
+
+	if _, err := (&Parser{}).Parse(""); !errors.Is(err, ErrEmpty) {
+		t.Errorf("Parse(\"\") error = %v, want ErrEmpty", err)
+	}
+	if _, err := (&Parser{}).Parse("nope"); errors.Is(err, ErrEmpty) {
+		t.Error("Parse(\"nope\") should not match ErrEmpty")
+	}
+}
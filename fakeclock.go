@@ -0,0 +1,250 @@
+package utc
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeClock is a Clock whose time moves only when Advance or Set is called.
+// Timers, tickers, sleepers, and AfterFunc functions fire during those calls,
+// in deadline order, with Now reporting each deadline as it fires. AfterFunc
+// functions run in the goroutine calling Advance or Set, so their effects are
+// visible when it returns. Timers created with a non-positive duration fire at
+// once, and their AfterFunc functions run in a new goroutine.
+//
+// Use BlockUntil to wait for the code under test to start waiting before
+// advancing the clock:
+//
+//	clock := utc.NewFakeClock(start)
+//	go worker(clock) // calls clock.Sleep(time.Minute)
+//	clock.BlockUntil(1)
+//	clock.Advance(time.Minute)
+//
+// The zero FakeClock starts at the zero Time and is ready to use. A FakeClock
+// is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond // signals new waiters to BlockUntil; created lazily
+	now     Time
+	waiters []*fakeTimer // pending timers and tickers, in firing order
+	seq     uint64       // creation counter that orders equal deadlines
+}
+
+// NewFakeClock returns a FakeClock set to start.
+func NewFakeClock(start Time) *FakeClock {
+	return &FakeClock{now: start}
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d, firing everything due on the way.
+// A negative d is treated as zero.
+func (c *FakeClock) Advance(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	c.Set(c.Now().Add(d))
+}
+
+// Set moves the clock to t, firing everything due at or before t.
+// Setting the clock backward changes Now but fires nothing.
+func (c *FakeClock) Set(t Time) {
+	for {
+		c.mu.Lock()
+		if len(c.waiters) == 0 || c.waiters[0].deadline.After(t) {
+			c.now = t
+			c.mu.Unlock()
+			return
+		}
+		w := c.waiters[0]
+		c.waiters = c.waiters[1:]
+		if c.now.Before(w.deadline) {
+			c.now = w.deadline
+		}
+		now := c.now
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+			c.schedule(w)
+		}
+		c.mu.Unlock()
+
+		if w.f != nil {
+			w.f()
+		} else {
+			w.send(now)
+		}
+	}
+}
+
+// BlockUntil blocks until at least n timers, tickers, and sleepers are
+// waiting on the clock.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cond == nil {
+		c.cond = sync.NewCond(&c.mu)
+	}
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+// Since returns the fake time elapsed since t.
+func (c *FakeClock) Since(t Time) time.Duration {
+	return c.Now().Sub(t)
+}
+
+// Until returns the fake duration until t.
+func (c *FakeClock) Until(t Time) time.Duration {
+	return t.Sub(c.Now())
+}
+
+// Sleep blocks until the clock has advanced by d. It returns at once if d <= 0.
+func (c *FakeClock) Sleep(d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	<-c.NewTimer(d).C()
+}
+
+// After returns a channel that receives the time once the clock has advanced
+// by d.
+func (c *FakeClock) After(d time.Duration) <-chan Time {
+	return c.NewTimer(d).C()
+}
+
+// AfterFunc returns a Timer that calls f once the clock has advanced by d.
+func (c *FakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	t := &fakeTimer{clock: c, f: f}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.start(t, d)
+	return t
+}
+
+// NewTimer returns a Timer that fires once the clock has advanced by d.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	t := &fakeTimer{clock: c, c: make(chan Time, 1)}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.start(t, d)
+	return t
+}
+
+// NewTicker returns a Ticker that fires each time the clock advances past
+// another multiple of d. It panics if d <= 0.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("utc: non-positive interval for FakeClock.NewTicker")
+	}
+	t := &fakeTimer{clock: c, c: make(chan Time, 1), period: d}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.start(t, d)
+	return fakeTicker{t}
+}
+
+// start schedules t to fire after d, or fires a one-shot timer at once if
+// d <= 0. c.mu must be held.
+func (c *FakeClock) start(t *fakeTimer, d time.Duration) {
+	c.seq++
+	t.seq = c.seq
+	t.deadline = c.now.Add(d)
+	if d <= 0 && t.period == 0 {
+		if t.f != nil {
+			go t.f()
+		} else {
+			t.send(c.now)
+		}
+		return
+	}
+	c.schedule(t)
+	if c.cond != nil {
+		c.cond.Broadcast()
+	}
+}
+
+// schedule inserts t into the pending list. c.mu must be held.
+func (c *FakeClock) schedule(t *fakeTimer) {
+	k := sort.Search(len(c.waiters), func(k int) bool {
+		w := c.waiters[k]
+		return t.deadline.Before(w.deadline) || (t.deadline.Equal(w.deadline) && t.seq < w.seq)
+	})
+	c.waiters = append(c.waiters, nil)
+	copy(c.waiters[k+1:], c.waiters[k:])
+	c.waiters[k] = t
+}
+
+// remove drops t from the pending list and reports whether it was pending.
+// c.mu must be held.
+func (c *FakeClock) remove(t *fakeTimer) bool {
+	for k, w := range c.waiters {
+		if w == t {
+			c.waiters = append(c.waiters[:k], c.waiters[k+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// fakeTimer implements Timer for FakeClock, and Ticker through fakeTicker.
+// Exactly one of c and f is set.
+type fakeTimer struct {
+	clock    *FakeClock
+	c        chan Time
+	f        func()
+	deadline Time
+	period   time.Duration // zero for a one-shot timer
+	seq      uint64
+}
+
+func (t *fakeTimer) send(now Time) {
+	select {
+	case t.c <- now:
+	default:
+	}
+}
+
+func (t *fakeTimer) C() <-chan Time {
+	return t.c
+}
+
+// Stop implements Timer.Stop.
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	return t.clock.remove(t)
+}
+
+// Reset implements Timer.Reset.
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	wasActive := t.clock.remove(t)
+	t.clock.start(t, d)
+	return wasActive
+}
+
+// fakeTicker adapts fakeTimer to the Ticker method set.
+type fakeTicker struct{ *fakeTimer }
+
+func (t fakeTicker) Stop() {
+	t.fakeTimer.Stop()
+}
+
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("utc: non-positive interval for Ticker.Reset")
+	}
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.clock.remove(t.fakeTimer)
+	t.period = d
+	t.clock.start(t.fakeTimer, d)
+}
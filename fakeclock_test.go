@@ -0,0 +1,261 @@
+package utc
+
+import (
+	"testing"
+	"time"
+)
+
+var fakeStart = New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+
+// recv returns the value waiting on c, failing if there is none.
+func recv(t *testing.T, c <-chan Time) Time {
+	t.Helper()
+	select {
+	case v := <-c:
+		return v
+	default:
+		t.Fatal("no value on channel")
+		return Time{}
+	}
+}
+
+// empty fails if a value is waiting on c.
+func empty(t *testing.T, c <-chan Time) {
+	t.Helper()
+	select {
+	case v := <-c:
+		t.Fatalf("unexpected value %v on channel", v)
+	default:
+	}
+}
+
+func TestFakeClock_Now(t *testing.T) {
+	var zero FakeClock
+	if !zero.Now().IsZero() {
+		t.Errorf("zero FakeClock Now() = %v", zero.Now())
+	}
+
+	c := NewFakeClock(fakeStart)
+	c.Advance(90 * time.Second)
+	if want := fakeStart.Add(90 * time.Second); !c.Now().Equal(want) {
+		t.Errorf("Now() after Advance = %v, want %v", c.Now(), want)
+	}
+	c.Advance(-time.Hour)
+	if want := fakeStart.Add(90 * time.Second); !c.Now().Equal(want) {
+		t.Errorf("negative Advance moved clock to %v", c.Now())
+	}
+	c.Set(fakeStart)
+	if !c.Now().Equal(fakeStart) {
+		t.Errorf("Now() after Set = %v", c.Now())
+	}
+}
+
+func TestFakeClock_TimersFireInOrder(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	late := c.NewTimer(3 * time.Second)
+	early := c.NewTimer(time.Second)
+	tie := c.NewTimer(time.Second)
+
+	c.Advance(500 * time.Millisecond)
+	empty(t, early.C())
+
+	c.Advance(time.Second)
+	if got := recv(t, early.C()); !got.Equal(fakeStart.Add(time.Second)) {
+		t.Errorf("early timer fired with %v", got)
+	}
+	if got := recv(t, tie.C()); !got.Equal(fakeStart.Add(time.Second)) {
+		t.Errorf("tied timer fired with %v", got)
+	}
+	empty(t, late.C())
+
+	c.Advance(time.Hour)
+	if got := recv(t, late.C()); !got.Equal(fakeStart.Add(3 * time.Second)) {
+		t.Errorf("late timer fired with %v, want its deadline", got)
+	}
+	if !c.Now().Equal(fakeStart.Add(time.Hour + 1500*time.Millisecond)) {
+		t.Errorf("Now() = %v after Advance", c.Now())
+	}
+}
+
+func TestFakeClock_TimerStopReset(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	timer := c.NewTimer(time.Second)
+	if !timer.Stop() {
+		t.Error("Stop() on pending timer = false")
+	}
+	if timer.Stop() {
+		t.Error("second Stop() = true")
+	}
+	c.Advance(time.Minute)
+	empty(t, timer.C())
+
+	if timer.Reset(time.Second) {
+		t.Error("Reset() on stopped timer = true")
+	}
+	if !timer.Reset(2 * time.Second) {
+		t.Error("Reset() on pending timer = false")
+	}
+	c.Advance(time.Second)
+	empty(t, timer.C())
+	c.Advance(time.Second)
+	if got := recv(t, timer.C()); !got.Equal(fakeStart.Add(time.Minute + 2*time.Second)) {
+		t.Errorf("reset timer fired with %v", got)
+	}
+	if timer.Stop() {
+		t.Error("Stop() after firing = true")
+	}
+}
+
+func TestFakeClock_Ticker(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	ticker := c.NewTicker(time.Second)
+
+	for i := 1; i <= 3; i++ {
+		c.Advance(time.Second)
+		if got := recv(t, ticker.C()); !got.Equal(fakeStart.Add(time.Duration(i) * time.Second)) {
+			t.Errorf("tick %d = %v", i, got)
+		}
+	}
+
+	// Like time.Ticker, ticks are dropped while the receiver lags.
+	c.Advance(5 * time.Second)
+	if got := recv(t, ticker.C()); !got.Equal(fakeStart.Add(4 * time.Second)) {
+		t.Errorf("first lagging tick = %v", got)
+	}
+	empty(t, ticker.C())
+
+	ticker.Reset(10 * time.Second)
+	c.Advance(9 * time.Second)
+	empty(t, ticker.C())
+	c.Advance(time.Second)
+	recv(t, ticker.C())
+
+	ticker.Stop()
+	c.Advance(time.Minute)
+	empty(t, ticker.C())
+}
+
+func TestFakeClock_TickerPanics(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	for name, f := range map[string]func(){
+		"NewTicker": func() { c.NewTicker(0) },
+		"Reset":     func() { c.NewTicker(time.Second).Reset(-time.Second) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s with non-positive interval did not panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestFakeClock_Concurrent(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			c.NewTimer(time.Duration(i) * time.Millisecond).Stop()
+			_ = c.Now()
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		c.Advance(time.Millisecond)
+	}
+	<-done
+}
+
+func TestFakeClock_SinceUntil(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	c.Advance(time.Hour)
+	if got := c.Since(fakeStart); got != time.Hour {
+		t.Errorf("Since() = %v, want 1h", got)
+	}
+	if got := c.Until(fakeStart.Add(3 * time.Hour)); got != 2*time.Hour {
+		t.Errorf("Until() = %v, want 2h", got)
+	}
+}
+
+func TestFakeClock_SleepBlockUntil(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	woke := make(chan Time)
+	for i := 1; i <= 3; i++ {
+		go func(d time.Duration) {
+			c.Sleep(d)
+			woke <- c.Now()
+		}(time.Duration(i) * time.Minute)
+	}
+	c.BlockUntil(3)
+
+	c.Advance(90 * time.Second)
+	<-woke
+	c.BlockUntil(2)
+	c.Advance(2 * time.Minute)
+	<-woke
+	<-woke
+	c.BlockUntil(0)
+
+	c.Sleep(0)
+	c.Sleep(-time.Second)
+}
+
+func TestFakeClock_After(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	ch := c.After(time.Second)
+	empty(t, ch)
+	c.Advance(time.Second)
+	if got := recv(t, ch); !got.Equal(fakeStart.Add(time.Second)) {
+		t.Errorf("After() delivered %v", got)
+	}
+
+	if got := recv(t, c.After(0)); !got.Equal(fakeStart.Add(time.Second)) {
+		t.Errorf("After(0) delivered %v, want the current time", got)
+	}
+	timer := c.NewTimer(time.Hour)
+	timer.Reset(-time.Second)
+	recv(t, timer.C())
+}
+
+func TestFakeClock_AfterFunc(t *testing.T) {
+	c := NewFakeClock(fakeStart)
+	var order []string
+	record := func(name string) func() {
+		return func() { order = append(order, name+"@"+c.Now().Sub(fakeStart).String()) }
+	}
+	c.AfterFunc(2*time.Second, record("b"))
+	c.AfterFunc(time.Second, record("a"))
+	stopped := c.AfterFunc(time.Second, record("stopped"))
+	tick := c.NewTicker(1500 * time.Millisecond)
+	c.AfterFunc(3*time.Second, func() {
+		order = append(order, "tick@"+recv(t, tick.C()).Sub(fakeStart).String())
+	})
+
+	if stopped.C() != nil {
+		t.Error("AfterFunc timer C() is not nil")
+	}
+	if !stopped.Stop() {
+		t.Error("Stop() on pending AfterFunc timer = false")
+	}
+	c.Advance(time.Minute)
+	want := []string{"a@1s", "b@2s", "tick@1.5s"}
+	if len(order) != len(want) {
+		t.Fatalf("calls = %v, want %v", order, want)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("calls = %v, want %v", order, want)
+			break
+		}
+	}
+
+	done := make(chan struct{})
+	c.AfterFunc(0, func() { close(done) })
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("AfterFunc(0) did not run")
+	}
+}
@@ -0,0 +1,51 @@
+package utc
+
+import (
+	"strings"
+	"time"
+)
+
+// Infinity sentinels for open-ended ranges, such as a validity that never
+// expires or a PostgreSQL timestamptz 'infinity'.
+//
+// PositiveInfinity is the latest instant a Time can hold and NegativeInfinity
+// the earliest instant with a Unix seconds representation, so Before, After,
+// and Equal order them correctly. They leave no room for calendar arithmetic,
+// so Add, AddDate, AddPeriod, AddBusinessDays, the StartOf and EndOf
+// helpers, Truncate, and Round return them unchanged, and Interval.SplitByDay
+// does not split at an infinite bound. They encode as "infinity" and
+// "-infinity" in JSON, text, YAML, and SQL, and those strings decode back to
+// them.
+var (
+	PositiveInfinity = Time{t: time.Unix(maxUnixSec, 999999999).UTC()}
+	NegativeInfinity = Time{t: time.Unix(minUnixSec, 0).UTC()}
+)
+
+// IsInfinite reports whether t is PositiveInfinity or NegativeInfinity.
+// Instants before NegativeInfinity also count as negative infinity.
+func (t Time) IsInfinite() bool {
+	return !t.Before(PositiveInfinity) || !t.After(NegativeInfinity)
+}
+
+// infinityString returns "infinity" or "-infinity" for an infinite t.
+func (t Time) infinityString() (string, bool) {
+	switch {
+	case !t.Before(PositiveInfinity):
+		return "infinity", true
+	case !t.After(NegativeInfinity):
+		return "-infinity", true
+	}
+	return "", false
+}
+
+// parseInfinity returns the sentinel named by s, if any. A leading '+' is
+// allowed and letter case is ignored.
+func parseInfinity(s string) (time.Time, bool) {
+	switch strings.ToLower(s) {
+	case "infinity", "+infinity":
+		return PositiveInfinity.t, true
+	case "-infinity":
+		return NegativeInfinity.t, true
+	}
+	return time.Time{}, false
+}
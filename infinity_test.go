@@ -0,0 +1,268 @@
+package utc
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestInfinity_Ordering(t *testing.T) {
+	now := Now()
+	extremes := []Time{
+		New(time.Date(-100000, 1, 1, 0, 0, 0, 0, time.UTC)),
+		{},
+		now,
+		New(time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)),
+		New(time.Date(1000000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	for _, ut := range extremes {
+		if !NegativeInfinity.Before(ut) || !PositiveInfinity.After(ut) {
+			t.Errorf("infinities do not bracket %v", ut)
+		}
+		if ut.IsInfinite() {
+			t.Errorf("IsInfinite(%v) = true", ut)
+		}
+	}
+	if !NegativeInfinity.Before(PositiveInfinity) || !PositiveInfinity.Equal(PositiveInfinity) || PositiveInfinity.Equal(NegativeInfinity) {
+		t.Error("infinities are not ordered")
+	}
+	if !PositiveInfinity.IsInfinite() || !NegativeInfinity.IsInfinite() || PositiveInfinity.IsZero() {
+		t.Error("IsInfinite()/IsZero() wrong for sentinels")
+	}
+
+	if got := PositiveInfinity.Add(time.Hour); !got.Equal(PositiveInfinity) {
+		t.Errorf("PositiveInfinity.Add() = %v", got)
+	}
+	if got := NegativeInfinity.Add(-time.Hour); !got.Equal(NegativeInfinity) {
+		t.Errorf("NegativeInfinity.Add() = %v", got)
+	}
+	if d := PositiveInfinity.Sub(now); d <= 0 {
+		t.Errorf("PositiveInfinity.Sub(now) = %v, want saturated positive", d)
+	}
+
+	open := NewInterval(now, PositiveInfinity)
+	if !open.Contains(New(time.Date(5000, 1, 1, 0, 0, 0, 0, time.UTC))) || open.Contains(now.Add(-time.Nanosecond)) {
+		t.Error("open-ended interval containment is wrong")
+	}
+}
+
+func TestInfinity_Encoding(t *testing.T) {
+	type window struct {
+		From  *Time `json:"from"`
+		Until *Time `json:"until"`
+	}
+	neg, pos := NegativeInfinity, PositiveInfinity
+	data, err := json.Marshal(window{From: &neg, Until: &pos})
+	if err != nil || string(data) != `{"from":"-infinity","until":"infinity"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+	var decoded window
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !decoded.From.Equal(NegativeInfinity) || !decoded.Until.Equal(PositiveInfinity) {
+		t.Errorf("json.Unmarshal() = %v / %v", decoded.From, decoded.Until)
+	}
+
+	if text, err := PositiveInfinity.MarshalText(); err != nil || string(text) != "infinity" {
+		t.Errorf("MarshalText() = %q, %v", text, err)
+	}
+	var ut Time
+	if err := ut.UnmarshalText([]byte("-infinity")); err != nil || !ut.Equal(NegativeInfinity) {
+		t.Errorf("UnmarshalText() = %v, %v", ut, err)
+	}
+	if got, err := NegativeInfinity.MarshalYAML(); err != nil || got != "-infinity" {
+		t.Errorf("MarshalYAML() = %v, %v", got, err)
+	}
+	err = ut.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "infinity"
+		return nil
+	})
+	if err != nil || !ut.Equal(PositiveInfinity) {
+		t.Errorf("UnmarshalYAML() = %v, %v", ut, err)
+	}
+	if got := PositiveInfinity.String(); got != "infinity" {
+		t.Errorf("String() = %q", got)
+	}
+	if got, err := (&Parser{Mode: ParseLenient}).Parse(" +Infinity "); err != nil || !got.Equal(PositiveInfinity) {
+		t.Errorf("lenient Parse(+Infinity) = %v, %v", got, err)
+	}
+
+	t.Cleanup(func() { SetDefaultJSONFormat(JSONRFC3339Nano) })
+	SetDefaultJSONFormat(JSONUnixMilli)
+	if data, err := json.Marshal(&pos); err != nil || string(data) != `"infinity"` {
+		t.Errorf("json.Marshal() with Unix format = %s, %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`"-infinity"`), &ut); err != nil || !ut.Equal(NegativeInfinity) {
+		t.Errorf("json.Unmarshal() with Unix format = %v, %v", ut, err)
+	}
+}
+
+func TestInfinity_SQL(t *testing.T) {
+	value, err := PositiveInfinity.Value()
+	if err != nil || value != "infinity" {
+		t.Fatalf("Value() = %#v, %v", value, err)
+	}
+	var ut Time
+	if err := ut.Scan(value); err != nil || !ut.Equal(PositiveInfinity) {
+		t.Errorf("Scan(Value()) = %v, %v", ut, err)
+	}
+
+	db := openEcho(t)
+	for _, d := range []Dialect{DialectPostgres, DialectSQLite} {
+		inf := NegativeInfinity
+		var scanned Time
+		if err := db.QueryRow("echo", d.Time(&inf)).Scan(d.Time(&scanned)); err != nil || !scanned.Equal(NegativeInfinity) {
+			t.Errorf("%v round trip = %v, %v", d, scanned, err)
+		}
+	}
+	for _, d := range []Dialect{DialectMySQL, DialectSQLServer} {
+		inf := PositiveInfinity
+		if _, err := d.Time(&inf).Value(); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("%v Value(infinity) error = %v, want ErrOutOfRange", d, err)
+		}
+	}
+}
+
+func TestInfinity_Calendar(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	ops := []struct {
+		name string
+		f    func(Time) Time
+	}{
+		{"AddDate", func(ut Time) Time { return ut.AddDate(0, 0, 1) }},
+		{"AddDate backwards", func(ut Time) Time { return ut.AddDate(-1, 0, 0) }},
+		{"AddDateOverflow", func(ut Time) Time { u, _ := ut.AddDateOverflow(0, 1, 1, OverflowError); return u }},
+		{"AddMonths", func(ut Time) Time { u, _ := ut.AddMonths(1, OverflowClamp); return u }},
+		{"AddYears", func(ut Time) Time { u, _ := ut.AddYears(-1, OverflowClamp); return u }},
+		{"AddPeriod", func(ut Time) Time { return ut.AddPeriod(Period{Days: 1}) }},
+		{"AddPeriod negative", func(ut Time) Time { return ut.AddPeriod(Period{Months: -1, Hours: -1}) }},
+		{"AddBusinessDays", func(ut Time) Time { return ut.AddBusinessDays(1) }},
+		{"AddBusinessDays backwards", func(ut Time) Time { return ut.AddBusinessDays(-7) }},
+		{"StartOfDay", Time.StartOfDay},
+		{"EndOfDay", Time.EndOfDay},
+		{"StartOfHour", Time.StartOfHour},
+		{"EndOfHour", Time.EndOfHour},
+		{"StartOfWeek", func(ut Time) Time { return ut.StartOfWeek(time.Sunday) }},
+		{"EndOfWeek", func(ut Time) Time { return ut.EndOfWeek(time.Monday) }},
+		{"StartOfMonth", Time.StartOfMonth},
+		{"EndOfMonth", Time.EndOfMonth},
+		{"StartOfQuarter", Time.StartOfQuarter},
+		{"EndOfQuarter", Time.EndOfQuarter},
+		{"StartOfHalfYear", Time.StartOfHalfYear},
+		{"EndOfHalfYear", Time.EndOfHalfYear},
+		{"StartOfYear", Time.StartOfYear},
+		{"EndOfYear", Time.EndOfYear},
+		{"StartOfDayIn", func(ut Time) Time { return ut.StartOfDayIn(newYork) }},
+		{"EndOfDayIn", func(ut Time) Time { return ut.EndOfDayIn(newYork) }},
+		{"StartOfWeekIn", func(ut Time) Time { return ut.StartOfWeekIn(time.Monday, newYork) }},
+		{"EndOfWeekIn", func(ut Time) Time { return ut.EndOfWeekIn(time.Monday, newYork) }},
+		{"Truncate", func(ut Time) Time { return ut.Truncate(time.Hour) }},
+		{"Round", func(ut Time) Time { return ut.Round(time.Hour) }},
+		{"TruncateTo", func(ut Time) Time { return ut.TruncateTo(Millisecond) }},
+		{"RoundTo", func(ut Time) Time { return ut.RoundTo(Second) }},
+	}
+	for _, op := range ops {
+		for _, inf := range []Time{PositiveInfinity, NegativeInfinity} {
+			if got := op.f(inf); got != inf {
+				t.Errorf("%s(%v) = %v, want it unchanged", op.name, inf, got)
+			}
+		}
+	}
+
+	for _, inf := range []Time{PositiveInfinity, NegativeInfinity} {
+		if r := inf.DayRangeIn(newYork); !r.IsEmpty() || r.Start != inf {
+			t.Errorf("DayRangeIn(%v) = %v, want empty interval at %v", inf, r, inf)
+		}
+	}
+}
+
+func TestInfinity_SplitByDay(t *testing.T) {
+	// Add leaves infinities unchanged, so step back from them with time.Time.
+	before := func(d time.Duration) Time { return New(PositiveInfinity.Time().Add(-d)) }
+	tests := []struct {
+		name string
+		i    Interval
+		want []Interval
+	}{
+		{
+			"ends at infinity",
+			NewInterval(before(50*time.Hour), PositiveInfinity),
+			[]Interval{NewInterval(before(50*time.Hour), PositiveInfinity)},
+		},
+		{
+			"starts at negative infinity",
+			NewInterval(NegativeInfinity, New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))),
+			[]Interval{NewInterval(NegativeInfinity, New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))},
+		},
+		{
+			"unbounded",
+			NewInterval(NegativeInfinity, PositiveInfinity),
+			[]Interval{NewInterval(NegativeInfinity, PositiveInfinity)},
+		},
+		{
+			"last representable day",
+			NewInterval(before(50*time.Hour), before(time.Hour)),
+			nil, // checked below
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.i.SplitByDay()
+			if tt.want != nil {
+				if len(got) != len(tt.want) || got[0] != tt.want[0] {
+					t.Errorf("SplitByDay() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if len(got) == 0 || got[0].Start != tt.i.Start || got[len(got)-1].End != tt.i.End {
+				t.Fatalf("SplitByDay() = %v, want pieces covering %v", got, tt.i)
+			}
+			for k, piece := range got {
+				if piece.IsEmpty() || (k > 0 && piece.Start != got[k-1].End) {
+					t.Errorf("SplitByDay() piece %d = %v is empty or not contiguous", k, piece)
+				}
+			}
+		})
+	}
+}
+
+func TestInfinity_Duration(t *testing.T) {
+	now := New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name string
+		i    Interval
+		want time.Duration
+	}{
+		{"open end", NewInterval(now, PositiveInfinity), math.MaxInt64},
+		{"open start", NewInterval(NegativeInfinity, now), math.MaxInt64},
+		{"unbounded", NewInterval(NegativeInfinity, PositiveInfinity), math.MaxInt64},
+		{"empty at infinity", NewInterval(PositiveInfinity, PositiveInfinity), 0},
+		{"too long", NewInterval(New(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)), now), math.MaxInt64},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.i.Duration(); got != tt.want {
+				t.Errorf("Duration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Two finite intervals of about 200 years each overflow the sum.
+	set := NewIntervalSet(
+		NewInterval(New(time.Date(1600, 1, 1, 0, 0, 0, 0, time.UTC)), New(time.Date(1800, 1, 1, 0, 0, 0, 0, time.UTC))),
+		NewInterval(New(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)), New(time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC))),
+	)
+	if got := set.Duration(); got != math.MaxInt64 {
+		t.Errorf("IntervalSet.Duration() = %v, want saturation", got)
+	}
+	open := NewIntervalSet(NewInterval(NegativeInfinity, now), NewInterval(now.Add(time.Hour), PositiveInfinity))
+	if got := open.Duration(); got != math.MaxInt64 {
+		t.Errorf("IntervalSet.Duration() with open ends = %v, want saturation", got)
+	}
+}
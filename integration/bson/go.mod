@@ -0,0 +1,10 @@
+module github.com/agentstation/utc/integration/bson
+
+go 1.21.0
+
+require (
+	github.com/agentstation/utc v0.0.0
+	go.mongodb.org/mongo-driver/v2 v2.8.0
+)
+
+replace github.com/agentstation/utc => ../..
@@ -0,0 +1,124 @@
+package bsonintegration_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/agentstation/utc"
+	"go.mongodb.org/mongo-driver/v2/bson"
+)
+
+type event struct {
+	At       utc.Time  `bson:"at"`
+	Optional utc.Time  `bson:"optional"`
+	Ptr      *utc.Time `bson:"ptr"`
+}
+
+func TestBSONDatetimeRoundTrip(t *testing.T) {
+	at := utc.New(time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.FixedZone("EST", -5*3600)))
+	in := event{At: at, Ptr: &at}
+
+	data, err := bson.Marshal(in)
+	if err != nil {
+		t.Fatalf("bson.Marshal() error = %v", err)
+	}
+
+	raw := bson.Raw(data)
+	if typ := raw.Lookup("at").Type; typ != bson.TypeDateTime {
+		t.Errorf("at encoded as %v, want datetime", typ)
+	}
+	if typ := raw.Lookup("optional").Type; typ != bson.TypeNull {
+		t.Errorf("zero utc.Time encoded as %v, want null", typ)
+	}
+	if ms, ok := raw.Lookup("at").DateTimeOK(); !ok || ms != at.UnixMilli() {
+		t.Errorf("at = %v, want %d ms", raw.Lookup("at"), at.UnixMilli())
+	}
+
+	var out event
+	if err := bson.Unmarshal(data, &out); err != nil {
+		t.Fatalf("bson.Unmarshal() error = %v", err)
+	}
+	if out.At != at || !out.Optional.IsZero() || out.Ptr == nil || *out.Ptr != at {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+	if out.At.Time().Location() != time.UTC {
+		t.Errorf("decoded location = %v, want UTC", out.At.Time().Location())
+	}
+}
+
+func TestBSONTruncatesToMilliseconds(t *testing.T) {
+	at := utc.Unix(1700000000, 123456789)
+	data, err := bson.Marshal(event{At: at})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out event
+	if err := bson.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if want := at.TruncateTo(utc.Millisecond); out.At != want {
+		t.Errorf("decoded %v, want %v", out.At, want)
+	}
+}
+
+func TestBSONDecodesDriverValues(t *testing.T) {
+	want := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		doc  bson.D
+		want utc.Time
+	}{
+		{"time.Time", bson.D{{Key: "at", Value: want}}, utc.New(want)},
+		{"bson.DateTime", bson.D{{Key: "at", Value: bson.NewDateTimeFromTime(want)}}, utc.New(want)},
+		{"string", bson.D{{Key: "at", Value: "2024-06-01T14:00:00+02:00"}}, utc.New(want)},
+		{"null", bson.D{{Key: "at", Value: nil}}, utc.Time{}},
+		{"undefined", bson.D{{Key: "at", Value: bson.Undefined{}}}, utc.Time{}},
+		{"missing", bson.D{}, utc.Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := bson.Marshal(tt.doc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got event
+			if err := bson.Unmarshal(data, &got); err != nil {
+				t.Fatalf("bson.Unmarshal() error = %v", err)
+			}
+			if got.At != tt.want {
+				t.Errorf("decoded %v, want %v", got.At, tt.want)
+			}
+		})
+	}
+
+	data, err := bson.Marshal(bson.D{{Key: "at", Value: int32(5)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got event
+	if err := bson.Unmarshal(data, &got); !errors.Is(err, utc.ErrUnsupportedType) {
+		t.Errorf("bson.Unmarshal(int32) error = %v, want ErrUnsupportedType", err)
+	}
+}
+
+func TestBSONGenericDecode(t *testing.T) {
+	at := utc.FromUnixMilli(1700000000123)
+	data, err := bson.Marshal(event{At: at})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc bson.M
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := doc["at"].(bson.DateTime); !ok || got.Time().UnixMilli() != at.UnixMilli() {
+		t.Errorf("generic decode of at = %#v, want bson.DateTime", doc["at"])
+	}
+}
+
+func TestBSONRejectsInfinity(t *testing.T) {
+	if _, err := bson.Marshal(event{At: utc.PositiveInfinity}); !errors.Is(err, utc.ErrOutOfRange) {
+		t.Errorf("bson.Marshal(PositiveInfinity) error = %v, want ErrOutOfRange", err)
+	}
+}
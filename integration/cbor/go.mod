@@ -0,0 +1,12 @@
+module github.com/agentstation/utc/integration/cbor
+
+go 1.21.0
+
+require (
+	github.com/agentstation/utc v0.0.0
+	github.com/fxamacker/cbor/v2 v2.9.2
+)
+
+require github.com/x448/float16 v0.8.4 // indirect
+
+replace github.com/agentstation/utc => ../..
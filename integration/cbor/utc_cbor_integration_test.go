@@ -0,0 +1,145 @@
+package cborintegration_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/agentstation/utc"
+	"github.com/fxamacker/cbor/v2"
+)
+
+type event struct {
+	At       utc.Time  `cbor:"at"`
+	Optional utc.Time  `cbor:"optional"`
+	Ptr      *utc.Time `cbor:"ptr"`
+}
+
+func TestCBORTags(t *testing.T) {
+	tests := []struct {
+		name string
+		t    utc.Time
+		tag  uint64
+	}{
+		{"tag 1 whole seconds", utc.Unix(1363896240, 0), 1},
+		{"tag 1 before epoch", utc.Unix(-86400, 0), 1},
+		{"tag 0 nanoseconds", utc.New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.FixedZone("EST", -5*3600))), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := cbor.Marshal(tt.t)
+			if err != nil {
+				t.Fatalf("cbor.Marshal() error = %v", err)
+			}
+
+			var raw cbor.RawTag
+			if err := cbor.Unmarshal(data, &raw); err != nil {
+				t.Fatalf("encoding %x is not a tag: %v", data, err)
+			}
+			if raw.Number != tt.tag {
+				t.Errorf("tag = %d, want %d", raw.Number, tt.tag)
+			}
+
+			var got utc.Time
+			if err := cbor.Unmarshal(data, &got); err != nil {
+				t.Fatalf("cbor.Unmarshal() error = %v", err)
+			}
+			if got != tt.t {
+				t.Errorf("round trip = %v, want %v", got, tt.t)
+			}
+
+			// The library decodes both tags into time.Time.
+			var std time.Time
+			if err := cbor.Unmarshal(data, &std); err != nil || !std.Equal(tt.t.Time()) {
+				t.Errorf("decode into time.Time = %v, %v, want %v", std, err, tt.t)
+			}
+			var generic any
+			if err := cbor.Unmarshal(data, &generic); err != nil {
+				t.Fatal(err)
+			}
+			if got, ok := generic.(time.Time); !ok || !got.Equal(tt.t.Time()) {
+				t.Errorf("generic decode = %#v, want native time.Time", generic)
+			}
+		})
+	}
+}
+
+func TestCBORDecodesLibraryTimeModes(t *testing.T) {
+	want := time.Date(2013, 3, 21, 20, 4, 0, 0, time.UTC)
+	modes := []struct {
+		name string
+		opts cbor.EncOptions
+	}{
+		{"tag 0 RFC 3339", cbor.EncOptions{Time: cbor.TimeRFC3339, TimeTag: cbor.EncTagRequired}},
+		{"tag 0 RFC 3339 nano", cbor.EncOptions{Time: cbor.TimeRFC3339Nano, TimeTag: cbor.EncTagRequired}},
+		{"tag 1 integer", cbor.EncOptions{Time: cbor.TimeUnix, TimeTag: cbor.EncTagRequired}},
+		{"tag 1 float", cbor.EncOptions{Time: cbor.TimeUnixMicro, TimeTag: cbor.EncTagRequired}},
+		{"untagged integer", cbor.EncOptions{Time: cbor.TimeUnix}},
+		{"untagged string", cbor.EncOptions{Time: cbor.TimeRFC3339Nano}},
+	}
+	for _, mode := range modes {
+		t.Run(mode.name, func(t *testing.T) {
+			em, err := mode.opts.EncMode()
+			if err != nil {
+				t.Fatal(err)
+			}
+			data, err := em.Marshal(struct {
+				At       time.Time `cbor:"at"`
+				Optional time.Time `cbor:"optional"`
+			}{At: want.In(time.FixedZone("", 3600))})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got event
+			if err := cbor.Unmarshal(data, &got); err != nil {
+				t.Fatalf("cbor.Unmarshal(%x) error = %v", data, err)
+			}
+			if got.At != utc.New(want) || !got.Optional.IsZero() {
+				t.Errorf("decoded %+v, want at=%v", got, want)
+			}
+		})
+	}
+}
+
+func TestCBORStructRoundTrip(t *testing.T) {
+	at := utc.Unix(1700000000, 250)
+	in := event{At: at, Ptr: &at}
+
+	data, err := cbor.Marshal(in)
+	if err != nil {
+		t.Fatalf("cbor.Marshal() error = %v", err)
+	}
+	var out event
+	if err := cbor.Unmarshal(data, &out); err != nil {
+		t.Fatalf("cbor.Unmarshal() error = %v", err)
+	}
+	if out.At != at || !out.Optional.IsZero() || out.Ptr == nil || *out.Ptr != at {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+
+	// The zero Time encodes as null, as the library does for time.Time.
+	zero, err := cbor.Marshal(utc.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	std, err := cbor.Marshal(time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(zero, std) {
+		t.Errorf("zero utc.Time encodes as %x, zero time.Time as %x", zero, std)
+	}
+}
+
+func TestCBORInfinityRoundTrip(t *testing.T) {
+	for _, inf := range []utc.Time{utc.PositiveInfinity, utc.NegativeInfinity} {
+		data, err := cbor.Marshal(inf)
+		if err != nil {
+			t.Fatalf("cbor.Marshal(%v) error = %v", inf, err)
+		}
+		var got utc.Time
+		if err := cbor.Unmarshal(data, &got); err != nil || got != inf {
+			t.Errorf("round trip of %v = %v, %v", inf, got, err)
+		}
+	}
+}
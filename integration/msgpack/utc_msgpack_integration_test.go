@@ -0,0 +1,136 @@
+package msgpackintegration_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/agentstation/utc"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+type event struct {
+	At       utc.Time  `msgpack:"at"`
+	Optional utc.Time  `msgpack:"optional"`
+	Ptr      *utc.Time `msgpack:"ptr"`
+}
+
+func TestMsgpackTimestampForms(t *testing.T) {
+	tests := []struct {
+		name   string
+		t      utc.Time
+		header []byte
+	}{
+		{"32-bit", utc.Unix(1700000000, 0), []byte{0xd6, 0xff}},
+		{"64-bit", utc.Unix(1700000000, 123456789), []byte{0xd7, 0xff}},
+		{"96-bit", utc.New(time.Date(1969, 7, 20, 20, 17, 40, 5, time.UTC)), []byte{0xc7, 12, 0xff}},
+		{"96-bit far future", utc.New(time.Date(2600, 1, 1, 0, 0, 0, 0, time.UTC)), []byte{0xc7, 12, 0xff}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := msgpack.Marshal(tt.t)
+			if err != nil {
+				t.Fatalf("msgpack.Marshal() error = %v", err)
+			}
+			if !bytes.HasPrefix(data, tt.header) {
+				t.Fatalf("msgpack.Marshal() = %x, want prefix %x", data, tt.header)
+			}
+
+			// The library's own time.Time encoding is byte-for-byte identical.
+			std, err := msgpack.Marshal(tt.t.Time())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(data, std) {
+				t.Errorf("utc.Time encodes as %x, time.Time as %x", data, std)
+			}
+
+			var got utc.Time
+			if err := msgpack.Unmarshal(data, &got); err != nil {
+				t.Fatalf("msgpack.Unmarshal() error = %v", err)
+			}
+			if got != tt.t {
+				t.Errorf("round trip = %v, want %v", got, tt.t)
+			}
+
+			var stdGot time.Time
+			if err := msgpack.Unmarshal(data, &stdGot); err != nil || !stdGot.Equal(tt.t.Time()) {
+				t.Errorf("decode into time.Time = %v, %v, want %v", stdGot, err, tt.t)
+			}
+		})
+	}
+}
+
+func TestMsgpackStructRoundTrip(t *testing.T) {
+	at := utc.New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.FixedZone("EST", -5*3600)))
+	in := event{At: at, Ptr: &at}
+
+	data, err := msgpack.Marshal(in)
+	if err != nil {
+		t.Fatalf("msgpack.Marshal() error = %v", err)
+	}
+	var out event
+	if err := msgpack.Unmarshal(data, &out); err != nil {
+		t.Fatalf("msgpack.Unmarshal() error = %v", err)
+	}
+	if out.At != at || !out.Optional.IsZero() || out.Ptr == nil || *out.Ptr != at {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+	if out.At.Time().Location() != time.UTC {
+		t.Errorf("decoded location = %v, want UTC", out.At.Time().Location())
+	}
+
+	var decoded map[string]any
+	if err := msgpack.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := decoded["at"].(time.Time); !ok || !got.Equal(at.Time()) {
+		t.Errorf("generic decode of at = %#v, want native time.Time", decoded["at"])
+	}
+	if decoded["optional"] != nil {
+		t.Errorf("zero utc.Time encoded as %#v, want nil", decoded["optional"])
+	}
+}
+
+func TestMsgpackDecodesTimeTimeAndStrings(t *testing.T) {
+	type stdEvent struct {
+		At       time.Time `msgpack:"at"`
+		Optional time.Time `msgpack:"optional"`
+	}
+	want := time.Date(2024, 6, 1, 12, 0, 0, 42, time.FixedZone("", 2*3600))
+	data, err := msgpack.Marshal(stdEvent{At: want})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got event
+	if err := msgpack.Unmarshal(data, &got); err != nil {
+		t.Fatalf("msgpack.Unmarshal() error = %v", err)
+	}
+	if got.At != utc.New(want) || !got.Optional.IsZero() {
+		t.Errorf("decode of time.Time fields = %+v", got)
+	}
+
+	data, err = msgpack.Marshal(map[string]string{"at": "2024-06-01T10:00:00Z"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := msgpack.Unmarshal(data, &got); err != nil {
+		t.Fatalf("msgpack.Unmarshal(string) error = %v", err)
+	}
+	if want := utc.New(time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)); got.At != want {
+		t.Errorf("decode of string = %v, want %v", got.At, want)
+	}
+}
+
+func TestMsgpackInfinityRoundTrip(t *testing.T) {
+	for _, inf := range []utc.Time{utc.PositiveInfinity, utc.NegativeInfinity} {
+		data, err := msgpack.Marshal(inf)
+		if err != nil {
+			t.Fatalf("msgpack.Marshal(%v) error = %v", inf, err)
+		}
+		var got utc.Time
+		if err := msgpack.Unmarshal(data, &got); err != nil || got != inf {
+			t.Errorf("round trip of %v = %v, %v", inf, got, err)
+		}
+	}
+}
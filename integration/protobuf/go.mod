@@ -0,0 +1,10 @@
+module github.com/agentstation/utc/integration/protobuf
+
+go 1.23
+
+require (
+	github.com/agentstation/utc v0.0.0
+	google.golang.org/protobuf v1.36.12
+)
+
+replace github.com/agentstation/utc => ../..
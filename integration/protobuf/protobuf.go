@@ -0,0 +1,120 @@
+// Package utcpb converts between utc.Time and the Protocol Buffers well-known
+// types google.protobuf.Timestamp and google.protobuf.Duration.
+//
+//	import utcpb "github.com/agentstation/utc/integration/protobuf"
+//
+//	created, err := utcpb.FromProto(req.GetCreatedAt())
+//	resp.UpdatedAt, err = utcpb.ToProto(updated)
+//
+// A Timestamp is valid between 0001-01-01T00:00:00Z and
+// 9999-12-31T23:59:59.999999999Z with nanos in [0, 999999999]. Conversions
+// outside that range fail with an error wrapping utc.ErrOutOfRange instead of
+// producing a message that other implementations reject.
+package utcpb
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/agentstation/utc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Range of a valid google.protobuf.Timestamp, in Unix seconds.
+const (
+	minTimestampSeconds = -62135596800 // 0001-01-01T00:00:00Z
+	maxTimestampSeconds = 253402300799 // 9999-12-31T23:59:59Z
+)
+
+var (
+	// MinTimestamp is the earliest instant a Timestamp can represent. It
+	// equals the zero utc.Time.
+	MinTimestamp = utc.Unix(minTimestampSeconds, 0)
+	// MaxTimestamp is the latest instant a Timestamp can represent.
+	MaxTimestamp = utc.Unix(maxTimestampSeconds, 999999999)
+)
+
+// FromProto returns the instant ts represents. It fails with an error
+// wrapping utc.ErrNil if ts is nil and utc.ErrOutOfRange if ts is invalid.
+func FromProto(ts *timestamppb.Timestamp) (utc.Time, error) {
+	if ts == nil {
+		return utc.Time{}, fmt.Errorf("cannot convert nil Timestamp to utc.Time: %w", utc.ErrNil)
+	}
+	if err := checkTimestamp(ts.GetSeconds(), ts.GetNanos()); err != nil {
+		return utc.Time{}, err
+	}
+	return utc.Unix(ts.GetSeconds(), int64(ts.GetNanos())), nil
+}
+
+// ToProto returns t as a Timestamp. It fails with an error wrapping
+// utc.ErrOutOfRange if t is outside [MinTimestamp, MaxTimestamp], including
+// utc.PositiveInfinity and utc.NegativeInfinity.
+func ToProto(t utc.Time) (*timestamppb.Timestamp, error) {
+	if t.Before(MinTimestamp) || t.After(MaxTimestamp) {
+		return nil, fmt.Errorf("cannot convert %s to Timestamp: %w", t, utc.ErrOutOfRange)
+	}
+	return &timestamppb.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Time().Nanosecond())}, nil
+}
+
+// FromProtoNull is like FromProto but maps a nil Timestamp, the usual encoding
+// of an unset message field, to an invalid NullTime.
+func FromProtoNull(ts *timestamppb.Timestamp) (utc.NullTime, error) {
+	if ts == nil {
+		return utc.NullTime{}, nil
+	}
+	t, err := FromProto(ts)
+	if err != nil {
+		return utc.NullTime{}, err
+	}
+	return utc.NullTimeFrom(t), nil
+}
+
+// ToProtoNull is like ToProto but maps an invalid NullTime to a nil Timestamp.
+func ToProtoNull(n utc.NullTime) (*timestamppb.Timestamp, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return ToProto(n.Time)
+}
+
+// DurationFromProto returns the time.Duration d represents. It fails with an
+// error wrapping utc.ErrNil if d is nil and utc.ErrOutOfRange if d is invalid
+// or does not fit in a time.Duration, which holds about ±292 years.
+func DurationFromProto(d *durationpb.Duration) (time.Duration, error) {
+	if d == nil {
+		return 0, fmt.Errorf("cannot convert nil Duration to time.Duration: %w", utc.ErrNil)
+	}
+	if err := d.CheckValid(); err != nil {
+		return 0, fmt.Errorf("cannot convert Duration: %v: %w", err, utc.ErrOutOfRange)
+	}
+	sec, nsec := d.GetSeconds(), int64(d.GetNanos())
+	if sec > math.MaxInt64/int64(time.Second) || sec < math.MinInt64/int64(time.Second) {
+		return 0, fmt.Errorf("cannot convert Duration of %ds to time.Duration: %w", sec, utc.ErrOutOfRange)
+	}
+	total := sec*int64(time.Second) + nsec
+	// Seconds and nanos share a sign, so overflow flips the sign of the sum.
+	if (sec > 0 && total < 0) || (sec < 0 && total > 0) {
+		return 0, fmt.Errorf("cannot convert Duration of %ds to time.Duration: %w", sec, utc.ErrOutOfRange)
+	}
+	return time.Duration(total), nil
+}
+
+// DurationToProto returns d as a Duration. Every time.Duration is within the
+// ±10000 years a Duration allows.
+func DurationToProto(d time.Duration) *durationpb.Duration {
+	return durationpb.New(d)
+}
+
+func checkTimestamp(sec int64, nanos int32) error {
+	switch {
+	case sec < minTimestampSeconds:
+		return fmt.Errorf("cannot convert Timestamp with seconds %d before 0001-01-01: %w", sec, utc.ErrOutOfRange)
+	case sec > maxTimestampSeconds:
+		return fmt.Errorf("cannot convert Timestamp with seconds %d after 9999-12-31: %w", sec, utc.ErrOutOfRange)
+	case nanos < 0 || nanos > 999999999:
+		return fmt.Errorf("cannot convert Timestamp with nanos %d outside [0, 999999999]: %w", nanos, utc.ErrOutOfRange)
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+package utcpb_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/agentstation/utc"
+	utcpb "github.com/agentstation/utc/integration/protobuf"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFromProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		ts      *timestamppb.Timestamp
+		want    utc.Time
+		wantErr error
+	}{
+		{"epoch", &timestamppb.Timestamp{}, utc.Unix(0, 0), nil},
+		{"nanos", &timestamppb.Timestamp{Seconds: 1704164645, Nanos: 123456789}, utc.New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)), nil},
+		{"before epoch", &timestamppb.Timestamp{Seconds: -1, Nanos: 999999999}, utc.Unix(0, -1), nil},
+		{"min", &timestamppb.Timestamp{Seconds: -62135596800}, utc.New(time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)), nil},
+		{"max", &timestamppb.Timestamp{Seconds: 253402300799, Nanos: 999999999}, utc.New(time.Date(9999, 12, 31, 23, 59, 59, 999999999, time.UTC)), nil},
+		{"below min", &timestamppb.Timestamp{Seconds: -62135596801, Nanos: 999999999}, utc.Time{}, utc.ErrOutOfRange},
+		{"above max", &timestamppb.Timestamp{Seconds: 253402300800}, utc.Time{}, utc.ErrOutOfRange},
+		{"negative nanos", &timestamppb.Timestamp{Seconds: 10, Nanos: -1}, utc.Time{}, utc.ErrOutOfRange},
+		{"nanos overflow", &timestamppb.Timestamp{Seconds: 10, Nanos: 1e9}, utc.Time{}, utc.ErrOutOfRange},
+		{"extreme seconds", &timestamppb.Timestamp{Seconds: math.MinInt64}, utc.Time{}, utc.ErrOutOfRange},
+		{"nil", nil, utc.Time{}, utc.ErrNil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utcpb.FromProto(tt.ts)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("FromProto() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("FromProto() = %v, want %v", got, tt.want)
+			}
+			// Every valid Timestamp agrees with the protobuf runtime.
+			if err == nil && !got.Time().Equal(tt.ts.AsTime()) {
+				t.Errorf("FromProto() = %v, AsTime() = %v", got, tt.ts.AsTime())
+			}
+		})
+	}
+}
+
+func TestToProto(t *testing.T) {
+	tests := []struct {
+		name    string
+		t       utc.Time
+		want    *timestamppb.Timestamp
+		wantErr bool
+	}{
+		{"zero is min", utc.Time{}, &timestamppb.Timestamp{Seconds: -62135596800}, false},
+		{"min", utcpb.MinTimestamp, &timestamppb.Timestamp{Seconds: -62135596800}, false},
+		{"max", utcpb.MaxTimestamp, &timestamppb.Timestamp{Seconds: 253402300799, Nanos: 999999999}, false},
+		{"before epoch", utc.Unix(-2, 250), &timestamppb.Timestamp{Seconds: -2, Nanos: 250}, false},
+		{"nanos", utc.New(time.Date(2024, 1, 2, 3, 4, 5, 6, time.FixedZone("EST", -5*3600))), &timestamppb.Timestamp{Seconds: 1704182645, Nanos: 6}, false},
+		{"below min", utcpb.MinTimestamp.Add(-time.Nanosecond), nil, true},
+		{"above max", utcpb.MaxTimestamp.Add(time.Nanosecond), nil, true},
+		{"positive infinity", utc.PositiveInfinity, nil, true},
+		{"negative infinity", utc.NegativeInfinity, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utcpb.ToProto(tt.t)
+			if tt.wantErr {
+				if !errors.Is(err, utc.ErrOutOfRange) || got != nil {
+					t.Fatalf("ToProto() = %v, %v, want ErrOutOfRange", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToProto() error = %v", err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("ToProto() = %v, want %v", got, tt.want)
+			}
+			if err := got.CheckValid(); err != nil {
+				t.Errorf("ToProto() produced invalid Timestamp: %v", err)
+			}
+			back, err := utcpb.FromProto(got)
+			if err != nil || back != tt.t.StripMonotonic() {
+				t.Errorf("FromProto(ToProto()) = %v, %v, want %v", back, err, tt.t)
+			}
+		})
+	}
+}
+
+func TestWireRoundTrip(t *testing.T) {
+	want := utc.Now()
+	ts, err := utcpb.ToProto(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := proto.Marshal(ts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded timestamppb.Timestamp
+	if err := proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	got, err := utcpb.FromProto(&decoded)
+	if err != nil || !got.Equal(want) {
+		t.Errorf("wire round trip = %v, %v, want %v", got, err, want)
+	}
+}
+
+func TestNull(t *testing.T) {
+	n, err := utcpb.FromProtoNull(nil)
+	if err != nil || n.Valid {
+		t.Errorf("FromProtoNull(nil) = %+v, %v, want invalid", n, err)
+	}
+	ts, err := utcpb.ToProtoNull(utc.NullTime{})
+	if err != nil || ts != nil {
+		t.Errorf("ToProtoNull(invalid) = %v, %v, want nil", ts, err)
+	}
+
+	at := utc.Unix(1700000000, 5)
+	ts, err = utcpb.ToProtoNull(utc.NullTimeFrom(at))
+	if err != nil || ts.GetSeconds() != 1700000000 || ts.GetNanos() != 5 {
+		t.Fatalf("ToProtoNull(valid) = %v, %v", ts, err)
+	}
+	n, err = utcpb.FromProtoNull(ts)
+	if err != nil || !n.Valid || n.Time != at {
+		t.Errorf("FromProtoNull(valid) = %+v, %v, want %v", n, err, at)
+	}
+
+	if _, err := utcpb.FromProtoNull(&timestamppb.Timestamp{Nanos: -5}); !errors.Is(err, utc.ErrOutOfRange) {
+		t.Errorf("FromProtoNull(invalid) error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := utcpb.ToProtoNull(utc.NullTimeFrom(utc.PositiveInfinity)); !errors.Is(err, utc.ErrOutOfRange) {
+		t.Errorf("ToProtoNull(infinity) error = %v, want ErrOutOfRange", err)
+	}
+}
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		name    string
+		d       *durationpb.Duration
+		want    time.Duration
+		wantErr error
+	}{
+		{"zero", &durationpb.Duration{}, 0, nil},
+		{"positive", &durationpb.Duration{Seconds: 90, Nanos: 500}, 90*time.Second + 500, nil},
+		{"negative", &durationpb.Duration{Seconds: -1, Nanos: -1}, -time.Second - 1, nil},
+		{"max", &durationpb.Duration{Seconds: 9223372036, Nanos: 854775807}, math.MaxInt64, nil},
+		{"min", &durationpb.Duration{Seconds: -9223372036, Nanos: -854775808}, math.MinInt64, nil},
+		{"above max", &durationpb.Duration{Seconds: 9223372036, Nanos: 854775808}, 0, utc.ErrOutOfRange},
+		{"below min", &durationpb.Duration{Seconds: -9223372036, Nanos: -854775809}, 0, utc.ErrOutOfRange},
+		{"valid but too long", &durationpb.Duration{Seconds: 315576000000}, 0, utc.ErrOutOfRange},
+		{"mixed signs", &durationpb.Duration{Seconds: 1, Nanos: -1}, 0, utc.ErrOutOfRange},
+		{"nil", nil, 0, utc.ErrNil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utcpb.DurationFromProto(tt.d)
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("DurationFromProto() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DurationFromProto() = %v, want %v", got, tt.want)
+			}
+			if err == nil {
+				if back := utcpb.DurationToProto(got); !proto.Equal(back, tt.d) {
+					t.Errorf("DurationToProto() = %v, want %v", back, tt.d)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+module github.com/agentstation/utc/integration/toml
+
+go 1.21.0
+
+require (
+	github.com/BurntSushi/toml v1.6.0
+	github.com/agentstation/utc v0.0.0
+	github.com/pelletier/go-toml/v2 v2.3.1
+)
+
+replace github.com/agentstation/utc => ../..
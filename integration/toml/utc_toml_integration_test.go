@@ -0,0 +1,133 @@
+package tomlintegration_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/agentstation/utc"
+	gotoml "github.com/pelletier/go-toml/v2"
+)
+
+type config struct {
+	Start   utc.Time  `toml:"start"`
+	End     *utc.Time `toml:"end"`
+	Created utc.Time  `toml:"created"`
+}
+
+func TestBurntSushiNativeDatetime(t *testing.T) {
+	start := utc.New(time.Date(1979, 5, 27, 0, 32, 0, 999999000, time.FixedZone("PDT", -7*3600)))
+	end := utc.PositiveInfinity
+	in := config{Start: start, End: &end}
+
+	var buf bytes.Buffer
+	if err := toml.NewEncoder(&buf).Encode(in); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	for _, line := range []string{
+		"start = 1979-05-27T07:32:00.999999Z",
+		`end = "infinity"`,
+		"created = 0001-01-01T00:00:00Z",
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("encoded TOML is missing %q:\n%s", line, buf.String())
+		}
+	}
+
+	var out config
+	md, err := toml.Decode(buf.String(), &out)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if out.Start != start || out.End == nil || !out.End.Equal(end) || !out.Created.IsZero() {
+		t.Errorf("round trip = %+v, want %+v", out, in)
+	}
+	if typ := md.Type("start"); typ != "Datetime" {
+		t.Errorf("start decoded from %s, want Datetime", typ)
+	}
+	if undecoded := md.Undecoded(); len(undecoded) != 0 {
+		t.Errorf("undecoded keys: %v", undecoded)
+	}
+}
+
+func TestBurntSushiDecode(t *testing.T) {
+	const doc = `
+start = 1979-05-27 00:32:00-07:00
+end = 1979-05-28T07:32:00
+created = 1979-05-27
+`
+	var out config
+	if _, err := toml.Decode(doc, &out); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	want := config{
+		Start:   utc.Unix(296638320, 0),
+		Created: utc.New(time.Date(1979, 5, 27, 0, 0, 0, 0, time.UTC)),
+	}
+	if out.Start != want.Start || out.Created != want.Created || out.End == nil || *out.End != utc.Unix(296638320+86400, 0) {
+		t.Errorf("Decode() = %+v", out)
+	}
+	if out.Start.Time().Location() != time.UTC {
+		t.Errorf("decoded location = %v, want UTC", out.Start.Time().Location())
+	}
+
+	if _, err := toml.Decode(`start = 07:32:00`, &out); err == nil {
+		t.Error("Decode() of a local time succeeded")
+	}
+	if _, err := toml.Decode(`start = "soon"`, &out); err == nil {
+		t.Error("Decode() of an invalid string succeeded")
+	}
+}
+
+func TestBurntSushiZoneless(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	t.Cleanup(func() { utc.SetDefaultParser(nil) })
+	utc.SetDefaultParser(&utc.Parser{Location: newYork})
+
+	var out config
+	if _, err := toml.Decode(`start = 2024-01-02T03:04:05`, &out); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if want := utc.New(time.Date(2024, 1, 2, 8, 4, 5, 0, time.UTC)); out.Start != want {
+		t.Errorf("local datetime in New York = %v, want %v", out.Start, want)
+	}
+
+	utc.SetDefaultParser(&utc.Parser{Zoneless: utc.ZonelessReject})
+	if _, err := toml.Decode(`start = 2024-01-02T03:04:05`, &out); err == nil {
+		t.Error("Decode() of a local datetime with ZonelessReject succeeded")
+	}
+}
+
+func TestGoTOML(t *testing.T) {
+	const doc = `
+start = 1979-05-27T00:32:00.999999-07:00
+end = "infinity"
+created = ""
+`
+	var out config
+	if err := gotoml.Unmarshal([]byte(doc), &out); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	start := utc.Unix(296638320, 999999000)
+	if out.Start != start || out.End == nil || !out.End.Equal(utc.PositiveInfinity) || !out.Created.IsZero() {
+		t.Errorf("Unmarshal() = %+v", out)
+	}
+
+	// go-toml writes text marshalers as strings, which read back the same.
+	data, err := gotoml.Marshal(out)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var back config
+	if err := gotoml.Unmarshal(data, &back); err != nil {
+		t.Fatalf("Unmarshal(%s) error = %v", data, err)
+	}
+	if back.Start != out.Start || !back.End.Equal(*out.End) || !back.Created.IsZero() {
+		t.Errorf("round trip = %+v, want %+v", back, out)
+	}
+}
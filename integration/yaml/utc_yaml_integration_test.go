@@ -59,3 +59,48 @@ func TestGoccyYAMLUnmarshalInvalidScalar(t *testing.T) {
 		t.Fatal("yaml.Unmarshal() unexpectedly succeeded")
 	}
 }
+
+func TestGoccyYAMLUnmarshalEpoch(t *testing.T) {
+	var decoded event
+	if err := yaml.Unmarshal([]byte("timestamp: 1704164645\n"), &decoded); err == nil {
+		t.Fatal("yaml.Unmarshal() of an epoch unexpectedly succeeded without Parser.Epoch")
+	}
+
+	p := utc.DefaultParser()
+	p.Epoch = utc.EpochAuto
+	utc.SetDefaultParser(p)
+	t.Cleanup(func() { utc.SetDefaultParser(nil) })
+
+	for _, input := range []string{"timestamp: 1704164645\n", "timestamp: 1704164645000\n", "timestamp: 1704164645.0\n"} {
+		if err := yaml.Unmarshal([]byte(input), &decoded); err != nil {
+			t.Fatalf("yaml.Unmarshal(%q) error = %v", input, err)
+		}
+		if got := decoded.Timestamp.Unix(); got != 1704164645 {
+			t.Errorf("yaml.Unmarshal(%q) = %v, want Unix 1704164645", input, decoded.Timestamp)
+		}
+	}
+}
+
+func TestGoccyYAMLNullTimeRoundTrip(t *testing.T) {
+	type record struct {
+		Null      utc.NullTime `yaml:"null"`
+		ValidZero utc.NullTime `yaml:"valid_zero"`
+		Set       utc.NullTime `yaml:"set"`
+	}
+	original := record{
+		ValidZero: utc.NullTimeFrom(utc.Time{}),
+		Set:       utc.NullTimeFrom(utc.Unix(1704164645, 5)),
+	}
+
+	data, err := yaml.Marshal(original)
+	if err != nil {
+		t.Fatalf("yaml.Marshal() error = %v", err)
+	}
+	var decoded record
+	if err := yaml.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("yaml.Unmarshal() error = %v", err)
+	}
+	if decoded != original {
+		t.Fatalf("round trip = %+v, want %+v\n%s", decoded, original, data)
+	}
+}
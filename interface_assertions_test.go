@@ -4,7 +4,9 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding"
+	"encoding/gob"
 	"encoding/json"
+	"encoding/xml"
 	"time"
 )
 
@@ -12,12 +14,70 @@ import (
 // method signatures. Value returns driver.Value, so database/sql/driver remains
 // a production import; Scanner is structural and can be proven here.
 var (
-	_ UTC                      = time.Time{}
-	_ UTC                      = Time{}
-	_ json.Marshaler           = (*Time)(nil)
-	_ json.Unmarshaler         = (*Time)(nil)
-	_ encoding.TextMarshaler   = Time{}
-	_ encoding.TextUnmarshaler = (*Time)(nil)
-	_ driver.Valuer            = Time{}
-	_ sql.Scanner              = (*Time)(nil)
+	_ UTC                        = time.Time{}
+	_ UTC                        = Time{}
+	_ json.Marshaler             = (*Time)(nil)
+	_ json.Unmarshaler           = (*Time)(nil)
+	_ encoding.TextMarshaler     = Time{}
+	_ encoding.TextUnmarshaler   = (*Time)(nil)
+	_ driver.Valuer              = Time{}
+	_ sql.Scanner                = (*Time)(nil)
+	_ encoding.BinaryMarshaler   = Time{}
+	_ encoding.BinaryUnmarshaler = (*Time)(nil)
+	_ gob.GobEncoder             = Time{}
+	_ gob.GobDecoder             = (*Time)(nil)
+	_ xml.Marshaler              = Time{}
+	_ xml.Unmarshaler            = (*Time)(nil)
+	_ xml.MarshalerAttr          = Time{}
+	_ xml.UnmarshalerAttr        = (*Time)(nil)
+
+	_ json.Marshaler           = Date{}
+	_ json.Unmarshaler         = (*Date)(nil)
+	_ encoding.TextMarshaler   = Date{}
+	_ encoding.TextUnmarshaler = (*Date)(nil)
+	_ driver.Valuer            = Date{}
+	_ sql.Scanner              = (*Date)(nil)
+
+	_ json.Marshaler           = TimeOfDay{}
+	_ json.Unmarshaler         = (*TimeOfDay)(nil)
+	_ encoding.TextMarshaler   = TimeOfDay{}
+	_ encoding.TextUnmarshaler = (*TimeOfDay)(nil)
+	_ driver.Valuer            = TimeOfDay{}
+	_ sql.Scanner              = (*TimeOfDay)(nil)
+
+	_ json.Marshaler           = NullTime{}
+	_ json.Unmarshaler         = (*NullTime)(nil)
+	_ encoding.TextMarshaler   = NullTime{}
+	_ encoding.TextUnmarshaler = (*NullTime)(nil)
+	_ driver.Valuer            = NullTime{}
+	_ sql.Scanner              = (*NullTime)(nil)
+
+	_ json.Marshaler           = Interval{}
+	_ json.Unmarshaler         = (*Interval)(nil)
+	_ encoding.TextMarshaler   = Interval{}
+	_ encoding.TextUnmarshaler = (*Interval)(nil)
+
+	_ json.Marshaler           = Period{}
+	_ json.Unmarshaler         = (*Period)(nil)
+	_ encoding.TextMarshaler   = Period{}
+	_ encoding.TextUnmarshaler = (*Period)(nil)
+
+	_ json.Unmarshaler         = (*Decoder)(nil)
+	_ encoding.TextUnmarshaler = (*Decoder)(nil)
+	_ sql.Scanner              = (*Decoder)(nil)
+
+	_ sql.Scanner   = (*DialectValue)(nil)
+	_ driver.Valuer = (*DialectValue)(nil)
+
+	_ json.Marshaler   = UnixSeconds{}
+	_ json.Unmarshaler = (*UnixSeconds)(nil)
+	_ json.Marshaler   = UnixMillis{}
+	_ json.Unmarshaler = (*UnixMillis)(nil)
+	_ json.Marshaler   = UnixMicros{}
+	_ json.Unmarshaler = (*UnixMicros)(nil)
+	_ json.Marshaler   = UnixNanos{}
+	_ json.Unmarshaler = (*UnixNanos)(nil)
+
+	_ Clock = realClock{}
+	_ Clock = (*FakeClock)(nil)
 )
@@ -0,0 +1,383 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Interval is a half-open time range [Start, End) in UTC.
+//
+// An interval whose End is not after its Start is empty: it contains no
+// instants and has zero duration.
+type Interval struct {
+	Start Time
+	End   Time
+}
+
+// NewInterval returns the interval [start, end).
+func NewInterval(start, end Time) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// ParseInterval parses an ISO 8601 "start/end" interval. Each side accepts the
+// same layouts as UnmarshalText.
+func ParseInterval(s string) (Interval, error) {
+	start, end, ok := strings.Cut(s, "/")
+	if !ok {
+		return Interval{}, fmt.Errorf("utc.Interval %q must be in start/end form", s)
+	}
+	startTime, err := parse(start)
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval start: %w", err)
+	}
+	endTime, err := parse(end)
+	if err != nil {
+		return Interval{}, fmt.Errorf("invalid interval end: %w", err)
+	}
+	return NewInterval(New(startTime), New(endTime)), nil
+}
+
+// IsEmpty reports whether i contains no instants.
+func (i Interval) IsEmpty() bool {
+	return !i.Start.Before(i.End)
+}
+
+// IsZero reports whether both bounds of i are the zero Time.
+func (i Interval) IsZero() bool {
+	return i.Start.IsZero() && i.End.IsZero()
+}
+
+// Duration returns the length of i, or zero if i is empty. It returns
+// math.MaxInt64, about 292 years, when a bound of a non-empty i is infinite
+// or the length does not fit in a time.Duration.
+func (i Interval) Duration() time.Duration {
+	if i.IsEmpty() {
+		return 0
+	}
+	if i.Start.IsInfinite() || i.End.IsInfinite() {
+		return math.MaxInt64
+	}
+	return i.End.Sub(i.Start)
+}
+
+// Contains reports whether t lies within i. Start is inclusive and End is exclusive.
+func (i Interval) Contains(t Time) bool {
+	return !t.Before(i.Start) && t.Before(i.End)
+}
+
+// ContainsInterval reports whether every instant of o lies within i.
+// An empty o is contained only if its Start lies within i or on i.End.
+func (i Interval) ContainsInterval(o Interval) bool {
+	if o.IsEmpty() {
+		return !o.Start.Before(i.Start) && !o.Start.After(i.End)
+	}
+	return !o.Start.Before(i.Start) && !o.End.After(i.End)
+}
+
+// Overlaps reports whether i and o share at least one instant.
+func (i Interval) Overlaps(o Interval) bool {
+	return !i.IsEmpty() && !o.IsEmpty() && i.Start.Before(o.End) && o.Start.Before(i.End)
+}
+
+// Abuts reports whether i and o are non-empty and meet end to start without overlapping.
+func (i Interval) Abuts(o Interval) bool {
+	return !i.IsEmpty() && !o.IsEmpty() && (i.End.Equal(o.Start) || o.End.Equal(i.Start))
+}
+
+// Intersect returns the instants shared by i and o.
+// The boolean result is false when the intervals do not overlap.
+func (i Interval) Intersect(o Interval) (Interval, bool) {
+	if !i.Overlaps(o) {
+		return Interval{}, false
+	}
+	return Interval{Start: latest(i.Start, o.Start), End: earliest(i.End, o.End)}, true
+}
+
+// Union returns the smallest interval covering both i and o.
+// The boolean result is false when the intervals neither overlap nor abut,
+// since their union would not be a single interval. An empty interval is
+// absorbed by the other operand.
+func (i Interval) Union(o Interval) (Interval, bool) {
+	switch {
+	case i.IsEmpty():
+		return o, true
+	case o.IsEmpty():
+		return i, true
+	case !i.Overlaps(o) && !i.Abuts(o):
+		return Interval{}, false
+	}
+	return Interval{Start: earliest(i.Start, o.Start), End: latest(i.End, o.End)}, true
+}
+
+// Gap returns the interval between i and o.
+// The boolean result is false when either interval is empty or they overlap or abut.
+func (i Interval) Gap(o Interval) (Interval, bool) {
+	if i.IsEmpty() || o.IsEmpty() || i.Overlaps(o) || i.Abuts(o) {
+		return Interval{}, false
+	}
+	if i.End.Before(o.Start) {
+		return Interval{Start: i.End, End: o.Start}, true
+	}
+	return Interval{Start: o.End, End: i.Start}, true
+}
+
+// SplitByDay splits i at UTC midnight boundaries. Each returned interval lies
+// within a single UTC day. An empty interval yields no pieces. An interval
+// with an infinite bound has no finite sequence of days, so it is returned
+// as a single piece.
+func (i Interval) SplitByDay() []Interval {
+	if i.IsEmpty() {
+		return nil
+	}
+	if i.Start.IsInfinite() || i.End.IsInfinite() {
+		return []Interval{i}
+	}
+	var pieces []Interval
+	for start := i.Start; start.Before(i.End); {
+		next := start.EndOfDay().Add(time.Nanosecond)
+		if !next.After(start) {
+			// The next midnight lies beyond the last representable instant.
+			next = i.End
+		}
+		end := earliest(next, i.End)
+		pieces = append(pieces, Interval{Start: start, End: end})
+		start = end
+	}
+	return pieces
+}
+
+// String implements fmt.Stringer. It prints the interval in ISO 8601
+// "start/end" form with RFC3339Nano bounds.
+func (i Interval) String() string {
+	return i.Start.String() + "/" + i.End.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface for Interval.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Interval.
+// JSON null and the empty string set the zero Interval.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	if i == nil {
+		debugLog("UnmarshalJSON() called on nil *Interval receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.Interval")
+	}
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty data into utc.Interval")
+	}
+
+	if string(data) == "null" {
+		*i = Interval{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("utc.Interval must be a JSON string or null: %w", err)
+	}
+
+	return i.set(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (i *Interval) UnmarshalText(text []byte) error {
+	if i == nil {
+		debugLog("UnmarshalText() called on nil *Interval receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.Interval")
+	}
+	return i.set(string(text))
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Interval.
+func (i *Interval) UnmarshalYAML(unmarshal func(any) error) error {
+	if i == nil {
+		debugLog("UnmarshalYAML() called on nil *Interval receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.Interval")
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	if err := i.set(s); err != nil {
+		return fmt.Errorf("failed to parse interval %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for Interval.
+func (i Interval) MarshalYAML() (any, error) {
+	if i.IsZero() {
+		return nil, nil
+	}
+	return i.String(), nil
+}
+
+// set parses s into i. An empty string sets the zero Interval.
+func (i *Interval) set(s string) error {
+	if s == "" {
+		*i = Interval{}
+		return nil
+	}
+	parsed, err := ParseInterval(s)
+	if err != nil {
+		return err
+	}
+	*i = parsed
+	return nil
+}
+
+// IntervalSet is a normalized set of instants represented as sorted,
+// non-overlapping, non-abutting, non-empty intervals.
+//
+// The zero value is an empty set. Operations return new sets and never modify
+// their receivers.
+type IntervalSet struct {
+	intervals []Interval
+}
+
+// NewIntervalSet returns the set covering every instant of the given intervals.
+// Empty intervals are dropped and overlapping or abutting intervals are merged.
+func NewIntervalSet(intervals ...Interval) IntervalSet {
+	sorted := make([]Interval, 0, len(intervals))
+	for _, iv := range intervals {
+		if !iv.IsEmpty() {
+			sorted = append(sorted, iv)
+		}
+	}
+	sort.Slice(sorted, func(a, b int) bool {
+		return sorted[a].Start.Before(sorted[b].Start)
+	})
+
+	merged := sorted[:0]
+	for _, iv := range sorted {
+		if n := len(merged); n > 0 && !merged[n-1].End.Before(iv.Start) {
+			merged[n-1].End = latest(merged[n-1].End, iv.End)
+			continue
+		}
+		merged = append(merged, iv)
+	}
+	return IntervalSet{intervals: merged}
+}
+
+// Intervals returns a copy of the normalized intervals in s, ordered by Start.
+func (s IntervalSet) Intervals() []Interval {
+	out := make([]Interval, len(s.intervals))
+	copy(out, s.intervals)
+	return out
+}
+
+// Len returns the number of disjoint intervals in s.
+func (s IntervalSet) Len() int {
+	return len(s.intervals)
+}
+
+// IsEmpty reports whether s contains no instants.
+func (s IntervalSet) IsEmpty() bool {
+	return len(s.intervals) == 0
+}
+
+// Duration returns the total length of all intervals in s. Like
+// Interval.Duration, it saturates at math.MaxInt64 instead of overflowing.
+func (s IntervalSet) Duration() time.Duration {
+	var total time.Duration
+	for _, iv := range s.intervals {
+		d := iv.Duration()
+		if d > math.MaxInt64-total {
+			return math.MaxInt64
+		}
+		total += d
+	}
+	return total
+}
+
+// Contains reports whether t lies within any interval of s.
+func (s IntervalSet) Contains(t Time) bool {
+	n := sort.Search(len(s.intervals), func(k int) bool {
+		return t.Before(s.intervals[k].End)
+	})
+	return n < len(s.intervals) && s.intervals[n].Contains(t)
+}
+
+// Add returns the set covering s and the given intervals.
+func (s IntervalSet) Add(intervals ...Interval) IntervalSet {
+	all := make([]Interval, 0, len(s.intervals)+len(intervals))
+	all = append(all, s.intervals...)
+	all = append(all, intervals...)
+	return NewIntervalSet(all...)
+}
+
+// Union returns the set of instants in s or o.
+func (s IntervalSet) Union(o IntervalSet) IntervalSet {
+	return s.Add(o.intervals...)
+}
+
+// Intersect returns the set of instants in both s and o.
+func (s IntervalSet) Intersect(o IntervalSet) IntervalSet {
+	var out []Interval
+	for a, b := 0, 0; a < len(s.intervals) && b < len(o.intervals); {
+		if iv, ok := s.intervals[a].Intersect(o.intervals[b]); ok {
+			out = append(out, iv)
+		}
+		if s.intervals[a].End.Before(o.intervals[b].End) {
+			a++
+		} else {
+			b++
+		}
+	}
+	return NewIntervalSet(out...)
+}
+
+// Subtract returns the set of instants in s that are not in o.
+func (s IntervalSet) Subtract(o IntervalSet) IntervalSet {
+	var out []Interval
+	b := 0
+	for _, iv := range s.intervals {
+		start := iv.Start
+		for b < len(o.intervals) && !o.intervals[b].End.After(start) {
+			b++
+		}
+		for k := b; k < len(o.intervals) && o.intervals[k].Start.Before(iv.End); k++ {
+			cut := o.intervals[k]
+			if cut.Start.After(start) {
+				out = append(out, Interval{Start: start, End: cut.Start})
+			}
+			start = latest(start, cut.End)
+		}
+		if start.Before(iv.End) {
+			out = append(out, Interval{Start: start, End: iv.End})
+		}
+	}
+	return NewIntervalSet(out...)
+}
+
+// earliest returns the earlier of a and b.
+func earliest(a, b Time) Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+// latest returns the later of a and b.
+func latest(a, b Time) Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
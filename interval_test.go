@@ -0,0 +1,253 @@
+package utc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func at(hour, minute int) Time {
+	return New(time.Date(2024, 1, 2, hour, minute, 0, 0, time.UTC))
+}
+
+func TestInterval_Basics(t *testing.T) {
+	iv := NewInterval(at(9, 0), at(17, 0))
+	if iv.Duration() != 8*time.Hour {
+		t.Errorf("Duration() = %v, want 8h", iv.Duration())
+	}
+	if !iv.Contains(at(9, 0)) || iv.Contains(at(17, 0)) || iv.Contains(at(8, 59)) {
+		t.Error("Contains() must be inclusive of Start and exclusive of End")
+	}
+	if iv.IsEmpty() || iv.IsZero() {
+		t.Error("IsEmpty()/IsZero() failed for non-empty interval")
+	}
+	reversed := NewInterval(at(17, 0), at(9, 0))
+	if !reversed.IsEmpty() || reversed.Duration() != 0 || reversed.Contains(at(12, 0)) {
+		t.Error("reversed interval should be empty")
+	}
+	if !iv.ContainsInterval(NewInterval(at(10, 0), at(17, 0))) || iv.ContainsInterval(NewInterval(at(10, 0), at(18, 0))) {
+		t.Error("ContainsInterval() failed")
+	}
+	if !iv.ContainsInterval(NewInterval(at(17, 0), at(17, 0))) || iv.ContainsInterval(NewInterval(at(18, 0), at(18, 0))) {
+		t.Error("ContainsInterval() failed for empty intervals")
+	}
+}
+
+func TestInterval_Algebra(t *testing.T) {
+	morning := NewInterval(at(9, 0), at(12, 0))
+	lunch := NewInterval(at(12, 0), at(13, 0))
+	afternoon := NewInterval(at(13, 30), at(17, 0))
+	overlap := NewInterval(at(11, 0), at(14, 0))
+
+	if morning.Overlaps(lunch) || !morning.Abuts(lunch) || !lunch.Abuts(morning) {
+		t.Error("adjacent intervals must abut without overlapping")
+	}
+	if !morning.Overlaps(overlap) || morning.Abuts(overlap) {
+		t.Error("Overlaps()/Abuts() failed")
+	}
+
+	if got, ok := morning.Intersect(overlap); !ok || got != NewInterval(at(11, 0), at(12, 0)) {
+		t.Errorf("Intersect() = %v, %v", got, ok)
+	}
+	if _, ok := morning.Intersect(lunch); ok {
+		t.Error("Intersect() of abutting intervals should be false")
+	}
+
+	if got, ok := morning.Union(lunch); !ok || got != NewInterval(at(9, 0), at(13, 0)) {
+		t.Errorf("Union(abutting) = %v, %v", got, ok)
+	}
+	if got, ok := morning.Union(overlap); !ok || got != NewInterval(at(9, 0), at(14, 0)) {
+		t.Errorf("Union(overlapping) = %v, %v", got, ok)
+	}
+	if _, ok := morning.Union(afternoon); ok {
+		t.Error("Union() of disjoint intervals should be false")
+	}
+	if got, ok := morning.Union(Interval{}); !ok || got != morning {
+		t.Errorf("Union(empty) = %v, %v", got, ok)
+	}
+
+	want := NewInterval(at(13, 0), at(13, 30))
+	if got, ok := lunch.Gap(afternoon); !ok || got != want {
+		t.Errorf("Gap() = %v, %v", got, ok)
+	}
+	if got, ok := afternoon.Gap(lunch); !ok || got != want {
+		t.Errorf("Gap() reversed = %v, %v", got, ok)
+	}
+	if _, ok := morning.Gap(lunch); ok {
+		t.Error("Gap() of abutting intervals should be false")
+	}
+}
+
+func TestInterval_SplitByDay(t *testing.T) {
+	start := New(time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC))
+	end := New(time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC))
+	pieces := NewInterval(start, end).SplitByDay()
+	want := []Interval{
+		NewInterval(start, New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))),
+		NewInterval(New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC))),
+		NewInterval(New(time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)), end),
+	}
+	if len(pieces) != len(want) {
+		t.Fatalf("SplitByDay() = %v, want %v", pieces, want)
+	}
+	for k := range want {
+		if pieces[k] != want[k] {
+			t.Errorf("SplitByDay()[%d] = %v, want %v", k, pieces[k], want[k])
+		}
+	}
+	if got := NewInterval(at(9, 0), at(10, 0)).SplitByDay(); len(got) != 1 {
+		t.Errorf("SplitByDay() within a day = %v", got)
+	}
+	if got := (Interval{}).SplitByDay(); got != nil {
+		t.Errorf("SplitByDay() of empty interval = %v", got)
+	}
+}
+
+func TestInterval_Encoding(t *testing.T) {
+	iv := NewInterval(at(9, 0), New(time.Date(2024, 1, 2, 17, 0, 0, 500, time.UTC)))
+	const text = "2024-01-02T09:00:00Z/2024-01-02T17:00:00.0000005Z"
+	if iv.String() != text {
+		t.Errorf("String() = %q", iv.String())
+	}
+
+	data, err := json.Marshal(struct {
+		Window Interval `json:"window"`
+	}{Window: iv})
+	if err != nil || string(data) != `{"window":"`+text+`"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    Interval
+		wantErr bool
+	}{
+		{name: "round trip", input: `"` + text + `"`, want: iv},
+		{name: "offsets normalized", input: `"2024-01-02T04:00:00-05:00/2024-01-02"`, want: NewInterval(at(9, 0), at(0, 0))},
+		{name: "null", input: `null`, want: Interval{}},
+		{name: "empty string", input: `""`, want: Interval{}},
+		{name: "missing separator", input: `"2024-01-02T09:00:00Z"`, wantErr: true},
+		{name: "invalid start", input: `"nope/2024-01-02"`, wantErr: true},
+		{name: "invalid end", input: `"2024-01-02/nope"`, wantErr: true},
+		{name: "number", input: `1`, wantErr: true},
+		{name: "empty data", input: ``, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Interval
+			err := got.UnmarshalJSON([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (!got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End)) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var decoded Interval
+	if err := decoded.UnmarshalText([]byte(text)); err != nil || decoded != iv {
+		t.Errorf("UnmarshalText() = %v, %v", decoded, err)
+	}
+	if marshaled, err := iv.MarshalYAML(); err != nil || marshaled != text {
+		t.Errorf("MarshalYAML() = %#v, %v", marshaled, err)
+	}
+	if marshaled, err := (Interval{}).MarshalYAML(); err != nil || marshaled != nil {
+		t.Errorf("MarshalYAML(zero) = %#v, %v", marshaled, err)
+	}
+	err = decoded.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "2024-01-02/2024-01-03"
+		return nil
+	})
+	if err != nil || decoded.Duration() != 24*time.Hour {
+		t.Errorf("UnmarshalYAML() = %v, %v", decoded, err)
+	}
+
+	var nilInterval *Interval
+	if err := nilInterval.UnmarshalJSON([]byte(`null`)); err == nil {
+		t.Error("UnmarshalJSON() on nil receiver should return error")
+	}
+}
+
+func TestIntervalSet_Normalization(t *testing.T) {
+	set := NewIntervalSet(
+		NewInterval(at(13, 0), at(14, 0)),
+		NewInterval(at(9, 0), at(10, 0)),
+		NewInterval(at(9, 30), at(11, 0)),
+		NewInterval(at(11, 0), at(12, 0)),
+		NewInterval(at(16, 0), at(15, 0)), // empty
+	)
+	want := []Interval{
+		NewInterval(at(9, 0), at(12, 0)),
+		NewInterval(at(13, 0), at(14, 0)),
+	}
+	got := set.Intervals()
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("NewIntervalSet() = %v, want %v", got, want)
+	}
+	if set.Len() != 2 || set.IsEmpty() || set.Duration() != 4*time.Hour {
+		t.Errorf("Len()/IsEmpty()/Duration() = %d/%v/%v", set.Len(), set.IsEmpty(), set.Duration())
+	}
+	if !set.Contains(at(9, 0)) || !set.Contains(at(13, 59)) || set.Contains(at(12, 0)) || set.Contains(at(14, 0)) {
+		t.Error("Contains() failed")
+	}
+	if !(IntervalSet{}).IsEmpty() {
+		t.Error("zero IntervalSet should be empty")
+	}
+
+	got[0] = Interval{}
+	if set.Intervals()[0] != want[0] {
+		t.Error("Intervals() must return a copy")
+	}
+}
+
+func TestIntervalSet_Operations(t *testing.T) {
+	busy := NewIntervalSet(
+		NewInterval(at(9, 0), at(10, 0)),
+		NewInterval(at(12, 0), at(13, 0)),
+		NewInterval(at(15, 0), at(16, 0)),
+	)
+	workday := NewIntervalSet(NewInterval(at(8, 0), at(17, 0)))
+
+	free := workday.Subtract(busy)
+	wantFree := []Interval{
+		NewInterval(at(8, 0), at(9, 0)),
+		NewInterval(at(10, 0), at(12, 0)),
+		NewInterval(at(13, 0), at(15, 0)),
+		NewInterval(at(16, 0), at(17, 0)),
+	}
+	assertIntervals(t, "Subtract()", free.Intervals(), wantFree)
+
+	afternoon := NewIntervalSet(NewInterval(at(12, 30), at(15, 30)))
+	assertIntervals(t, "Intersect()", busy.Intersect(afternoon).Intervals(), []Interval{
+		NewInterval(at(12, 30), at(13, 0)),
+		NewInterval(at(15, 0), at(15, 30)),
+	})
+	assertIntervals(t, "Union()", busy.Union(afternoon).Intervals(), []Interval{
+		NewInterval(at(9, 0), at(10, 0)),
+		NewInterval(at(12, 0), at(16, 0)),
+	})
+	assertIntervals(t, "Add()", busy.Add(NewInterval(at(10, 0), at(12, 0))).Intervals(), []Interval{
+		NewInterval(at(9, 0), at(13, 0)),
+		NewInterval(at(15, 0), at(16, 0)),
+	})
+	assertIntervals(t, "Subtract(self)", busy.Subtract(busy).Intervals(), nil)
+	assertIntervals(t, "Subtract(empty)", busy.Subtract(IntervalSet{}).Intervals(), busy.Intervals())
+
+	if free.Union(busy).Duration() != workday.Duration() {
+		t.Error("free ∪ busy should cover the workday")
+	}
+}
+
+func assertIntervals(t *testing.T, name string, got, want []Interval) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s = %v, want %v", name, got, want)
+	}
+	for k := range want {
+		if got[k] != want[k] {
+			t.Errorf("%s[%d] = %v, want %v", name, k, got[k], want[k])
+		}
+	}
+}
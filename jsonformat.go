@@ -0,0 +1,312 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// JSONFormat selects how Time values are encoded as JSON.
+type JSONFormat int
+
+// JSON formats. The Unix formats count whole units since the Unix epoch and
+// truncate finer precision toward the earlier instant.
+const (
+	// JSONRFC3339Nano writes an RFC 3339 string with trailing fractional
+	// zeros removed, such as "2024-01-02T03:04:05.12Z". It is the default.
+	JSONRFC3339Nano JSONFormat = iota
+	// JSONRFC3339 writes an RFC 3339 string with whole seconds.
+	JSONRFC3339
+	// JSONRFC3339Milli writes an RFC 3339 string with exactly three fractional digits.
+	JSONRFC3339Milli
+	// JSONRFC3339Micro writes an RFC 3339 string with exactly six fractional digits.
+	JSONRFC3339Micro
+	// JSONUnix writes Unix seconds as a JSON number.
+	JSONUnix
+	// JSONUnixMilli writes Unix milliseconds as a JSON number.
+	JSONUnixMilli
+	// JSONUnixMicro writes Unix microseconds as a JSON number.
+	JSONUnixMicro
+	// JSONUnixNano writes Unix nanoseconds as a JSON number.
+	JSONUnixNano
+	// JSONUnixString writes Unix seconds as a JSON string, such as "1704164645".
+	JSONUnixString
+	// JSONUnixMilliString writes Unix milliseconds as a JSON string.
+	JSONUnixMilliString
+	// JSONUnixMicroString writes Unix microseconds as a JSON string.
+	JSONUnixMicroString
+	// JSONUnixNanoString writes Unix nanoseconds as a JSON string.
+	JSONUnixNanoString
+)
+
+var jsonFormatNames = [...]string{
+	JSONRFC3339Nano:     "RFC3339Nano",
+	JSONRFC3339:         "RFC3339",
+	JSONRFC3339Milli:    "RFC3339Milli",
+	JSONRFC3339Micro:    "RFC3339Micro",
+	JSONUnix:            "Unix",
+	JSONUnixMilli:       "UnixMilli",
+	JSONUnixMicro:       "UnixMicro",
+	JSONUnixNano:        "UnixNano",
+	JSONUnixString:      "UnixString",
+	JSONUnixMilliString: "UnixMilliString",
+	JSONUnixMicroString: "UnixMicroString",
+	JSONUnixNanoString:  "UnixNanoString",
+}
+
+// String implements fmt.Stringer.
+func (f JSONFormat) String() string {
+	if f >= 0 && int(f) < len(jsonFormatNames) {
+		return jsonFormatNames[f]
+	}
+	return fmt.Sprintf("JSONFormat(%d)", int(f))
+}
+
+// epoch returns the unit of an epoch format and whether it is written as a
+// JSON string. ok is false for the RFC 3339 formats.
+func (f JSONFormat) epoch() (unit time.Duration, quoted, ok bool) {
+	switch f {
+	case JSONUnix, JSONUnixString:
+		unit = time.Second
+	case JSONUnixMilli, JSONUnixMilliString:
+		unit = time.Millisecond
+	case JSONUnixMicro, JSONUnixMicroString:
+		unit = time.Microsecond
+	case JSONUnixNano, JSONUnixNanoString:
+		unit = time.Nanosecond
+	default:
+		return 0, false, false
+	}
+	return unit, f >= JSONUnixString, true
+}
+
+var defaultJSONFormat int32 // JSONFormat
+
+// DefaultJSONFormat returns the format written by (*Time).MarshalJSON.
+func DefaultJSONFormat() JSONFormat {
+	return JSONFormat(atomic.LoadInt32(&defaultJSONFormat))
+}
+
+// SetDefaultJSONFormat sets the format written by (*Time).MarshalJSON and by
+// NullTime for valid values. When f is a Unix format, Time.UnmarshalJSON also
+// accepts JSON numbers in the same unit.
+//
+// It is not a package-wide JSON setting. MarshalJSON has a pointer receiver,
+// so encoding/json only calls it for *Time values and addressable Time
+// fields, such as those of a struct marshaled through a pointer. A Time
+// reached by value, as in json.Marshal(S{t}) or a map value, is encoded by
+// MarshalText as RFC 3339 with nanoseconds whatever the format. To fix the
+// encoding of a field in every case, declare it as UnixSeconds, UnixMillis,
+// UnixMicros, or UnixNanos instead.
+//
+// SetDefaultJSONFormat is safe to call concurrently with encoding, but is
+// intended to be called once during program initialization.
+func SetDefaultJSONFormat(f JSONFormat) {
+	if f < 0 || int(f) >= len(jsonFormatNames) {
+		panic(fmt.Sprintf("utc: invalid JSONFormat %d", int(f)))
+	}
+	atomic.StoreInt32(&defaultJSONFormat, int32(f))
+}
+
+// marshalJSON encodes t in format f.
+// Infinite values are always written as the strings "infinity" and "-infinity".
+func marshalJSON(t time.Time, f JSONFormat) ([]byte, error) {
+	if inf, ok := New(t).infinityString(); ok {
+		return []byte(`"` + inf + `"`), nil
+	}
+	unit, quoted, ok := f.epoch()
+	if !ok {
+		var layout string
+		switch f {
+		case JSONRFC3339:
+			layout = time.RFC3339
+		case JSONRFC3339Milli:
+			layout = rfc3339Milli
+		case JSONRFC3339Micro:
+			layout = rfc3339Micro
+		default:
+			return t.MarshalJSON()
+		}
+		if y := t.Year(); y < 0 || y > 9999 {
+			return nil, fmt.Errorf("utc.Time year %d outside of range [0,9999]", y)
+		}
+		b := make([]byte, 0, len(layout)+2)
+		b = append(b, '"')
+		b = t.AppendFormat(b, layout)
+		return append(b, '"'), nil
+	}
+
+	v, err := New(t).unixChecked(unit)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 0, 22)
+	if quoted {
+		b = append(b, '"')
+	}
+	b = strconv.AppendInt(b, v, 10)
+	if quoted {
+		b = append(b, '"')
+	}
+	return b, nil
+}
+
+// fromUnitIn returns the instant v units after the Unix epoch. It returns
+// ErrOutOfRange when the instant is not a finite Time.
+func fromUnitIn(v int64, unit time.Duration) (time.Time, error) {
+	perSec := int64(time.Second / unit)
+	sec, rem := v/perSec, v%perSec
+	if rem < 0 {
+		sec--
+		rem += perSec
+	}
+	t := time.Unix(sec, rem*int64(unit)).UTC()
+	if sec > maxUnixSec || New(t).IsInfinite() {
+		return time.Time{}, fmt.Errorf("utc.Time Unix %s %d: %w", unitName(unit), v, ErrOutOfRange)
+	}
+	return t, nil
+}
+
+// unitName names an epoch unit in error messages.
+func unitName(unit time.Duration) string {
+	switch unit {
+	case time.Second:
+		return "seconds"
+	case time.Millisecond:
+		return "milliseconds"
+	case time.Microsecond:
+		return "microseconds"
+	default:
+		return "nanoseconds"
+	}
+}
+
+// decodeEpochJSON decodes data as an integer number of units since the Unix
+// epoch, given either as a JSON number or as a JSON string of digits.
+// ok is false when data holds neither.
+func decodeEpochJSON(data []byte, unit time.Duration) (t time.Time, ok bool, err error) {
+	s := string(data)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return time.Time{}, false, nil
+		}
+		if !isInteger(s) {
+			return time.Time{}, false, nil
+		}
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		if isInteger(s) {
+			return time.Time{}, true, fmt.Errorf("utc.Time Unix %s %s out of range: %w", unitName(unit), s, err)
+		}
+		return time.Time{}, false, nil
+	}
+	t, err = fromUnitIn(v, unit)
+	return t, true, err
+}
+
+// isInteger reports whether s is a decimal integer with an optional minus sign.
+func isInteger(s string) bool {
+	s = strings.TrimPrefix(s, "-")
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// UnixSeconds is a Time encoded in JSON as a number of seconds since the Unix
+// epoch, regardless of the package default format. It decodes JSON numbers,
+// numeric strings, and the strings accepted by Time.UnmarshalJSON.
+type UnixSeconds struct{ Time }
+
+// UnixMillis is a Time encoded in JSON as a number of milliseconds since the
+// Unix epoch. It decodes like UnixSeconds, counting milliseconds.
+type UnixMillis struct{ Time }
+
+// UnixMicros is a Time encoded in JSON as a number of microseconds since the
+// Unix epoch. It decodes like UnixSeconds, counting microseconds.
+type UnixMicros struct{ Time }
+
+// UnixNanos is a Time encoded in JSON as a number of nanoseconds since the
+// Unix epoch. It decodes like UnixSeconds, counting nanoseconds. Instants
+// outside the years 1678 through 2262 cannot be encoded.
+type UnixNanos struct{ Time }
+
+// MarshalJSON implements the json.Marshaler interface for UnixSeconds.
+func (u UnixSeconds) MarshalJSON() ([]byte, error) {
+	return marshalJSON(u.utc(), JSONUnix)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for UnixSeconds.
+func (u *UnixSeconds) UnmarshalJSON(data []byte) error {
+	if u == nil {
+		debugLog("UnmarshalJSON() called on nil *UnixSeconds receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.UnixSeconds")
+	}
+	return unmarshalEpochJSON(&u.Time, data, time.Second)
+}
+
+// MarshalJSON implements the json.Marshaler interface for UnixMillis.
+func (u UnixMillis) MarshalJSON() ([]byte, error) {
+	return marshalJSON(u.utc(), JSONUnixMilli)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for UnixMillis.
+func (u *UnixMillis) UnmarshalJSON(data []byte) error {
+	if u == nil {
+		debugLog("UnmarshalJSON() called on nil *UnixMillis receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.UnixMillis")
+	}
+	return unmarshalEpochJSON(&u.Time, data, time.Millisecond)
+}
+
+// MarshalJSON implements the json.Marshaler interface for UnixMicros.
+func (u UnixMicros) MarshalJSON() ([]byte, error) {
+	return marshalJSON(u.utc(), JSONUnixMicro)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for UnixMicros.
+func (u *UnixMicros) UnmarshalJSON(data []byte) error {
+	if u == nil {
+		debugLog("UnmarshalJSON() called on nil *UnixMicros receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.UnixMicros")
+	}
+	return unmarshalEpochJSON(&u.Time, data, time.Microsecond)
+}
+
+// MarshalJSON implements the json.Marshaler interface for UnixNanos.
+func (u UnixNanos) MarshalJSON() ([]byte, error) {
+	return marshalJSON(u.utc(), JSONUnixNano)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for UnixNanos.
+func (u *UnixNanos) UnmarshalJSON(data []byte) error {
+	if u == nil {
+		debugLog("UnmarshalJSON() called on nil *UnixNanos receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.UnixNanos")
+	}
+	return unmarshalEpochJSON(&u.Time, data, time.Nanosecond)
+}
+
+// unmarshalEpochJSON decodes an epoch value in unit into t, falling back to
+// the package default parser for other strings.
+func unmarshalEpochJSON(t *Time, data []byte, unit time.Duration) error {
+	parsed, ok, err := decodeEpochJSON(bytes.TrimSpace(data), unit)
+	if err != nil {
+		return err
+	}
+	if ok {
+		t.t = parsed
+		return nil
+	}
+	return t.UnmarshalJSON(data)
+}
@@ -0,0 +1,251 @@
+package utc
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestJSONFormat_Marshal(t *testing.T) {
+	t.Cleanup(func() { SetDefaultJSONFormat(JSONRFC3339Nano) })
+
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 120000000, time.UTC))
+	tests := []struct {
+		format JSONFormat
+		want   string
+	}{
+		{JSONRFC3339Nano, `"2024-01-02T03:04:05.12Z"`},
+		{JSONRFC3339, `"2024-01-02T03:04:05Z"`},
+		{JSONRFC3339Milli, `"2024-01-02T03:04:05.120Z"`},
+		{JSONRFC3339Micro, `"2024-01-02T03:04:05.120000Z"`},
+		{JSONUnix, `1704164645`},
+		{JSONUnixMilli, `1704164645120`},
+		{JSONUnixMicro, `1704164645120000`},
+		{JSONUnixNano, `1704164645120000000`},
+		{JSONUnixString, `"1704164645"`},
+		{JSONUnixMilliString, `"1704164645120"`},
+		{JSONUnixMicroString, `"1704164645120000"`},
+		{JSONUnixNanoString, `"1704164645120000000"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format.String(), func(t *testing.T) {
+			SetDefaultJSONFormat(tt.format)
+			if got := DefaultJSONFormat(); got != tt.format {
+				t.Fatalf("DefaultJSONFormat() = %v, want %v", got, tt.format)
+			}
+			data, err := json.Marshal(&ut)
+			if err != nil || string(data) != tt.want {
+				t.Fatalf("json.Marshal() = %s, %v; want %s", data, err, tt.want)
+			}
+			nullData, err := json.Marshal(NullTimeFrom(ut))
+			if err != nil || string(nullData) != tt.want {
+				t.Errorf("json.Marshal(NullTime) = %s, %v; want %s", nullData, err, tt.want)
+			}
+
+			var got Time
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+			}
+			if _, _, epoch := tt.format.epoch(); !epoch || tt.format == JSONUnixMilli || tt.format == JSONUnixMilliString {
+				want := ut.UTC()
+				if tt.format == JSONRFC3339 {
+					want = want.Truncate(time.Second)
+				}
+				if !got.UTC().Equal(want) {
+					t.Errorf("round trip = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestJSONFormat_ByValue(t *testing.T) {
+	t.Cleanup(func() { SetDefaultJSONFormat(JSONRFC3339Nano) })
+	SetDefaultJSONFormat(JSONUnix)
+
+	type event struct {
+		At      Time        `json:"at"`
+		Seconds UnixSeconds `json:"s"`
+	}
+	ut := Unix(1700000000, 0)
+	in := event{At: ut, Seconds: UnixSeconds{ut}}
+
+	// MarshalJSON has a pointer receiver, so the default format only applies
+	// when the Time is addressable. The Unix wrappers encode the same either way.
+	byValue, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"at":"2023-11-14T22:13:20Z","s":1700000000}`; string(byValue) != want {
+		t.Errorf("json.Marshal(struct) = %s, want %s", byValue, want)
+	}
+	byPointer, err := json.Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"at":1700000000,"s":1700000000}`; string(byPointer) != want {
+		t.Errorf("json.Marshal(&struct) = %s, want %s", byPointer, want)
+	}
+
+	for _, data := range [][]byte{byValue, byPointer} {
+		var out event
+		if err := json.Unmarshal(data, &out); err != nil || out != in {
+			t.Errorf("json.Unmarshal(%s) = %+v, %v, want %+v", data, out, err, in)
+		}
+	}
+}
+
+func TestJSONFormat_EpochDecoding(t *testing.T) {
+	t.Cleanup(func() { SetDefaultJSONFormat(JSONRFC3339Nano) })
+
+	var ut Time
+	if err := json.Unmarshal([]byte(`1704164645`), &ut); err == nil {
+		t.Fatal("default format should reject JSON numbers")
+	}
+
+	SetDefaultJSONFormat(JSONUnix)
+	for _, input := range []string{`1704164645`, `"1704164645"`, `"2024-01-02T03:04:05Z"`} {
+		if err := json.Unmarshal([]byte(input), &ut); err != nil || ut.Unix() != 1704164645 {
+			t.Errorf("json.Unmarshal(%s) = %v, %v", input, ut, err)
+		}
+	}
+	// Strings try the layouts first, so a year is not read as seconds.
+	if err := json.Unmarshal([]byte(`"2024"`), &ut); err != nil || !ut.UTC().Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf(`json.Unmarshal("2024") = %v, %v`, ut, err)
+	}
+	if err := json.Unmarshal([]byte(`-1`), &ut); err != nil || !ut.UTC().Equal(time.Date(1969, 12, 31, 23, 59, 59, 0, time.UTC)) {
+		t.Errorf("json.Unmarshal(-1) = %v, %v", ut, err)
+	}
+	for _, input := range []string{`1.5`, `99999999999999999999`, `true`} {
+		if err := json.Unmarshal([]byte(input), &ut); err == nil {
+			t.Errorf("json.Unmarshal(%s) should return error", input)
+		}
+	}
+
+	for _, input := range []string{`9223372036854775807`, `-9223372036854775808`} {
+		if err := json.Unmarshal([]byte(input), &ut); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("json.Unmarshal(%s) error = %v, want ErrOutOfRange", input, err)
+		}
+	}
+
+	SetDefaultJSONFormat(JSONUnixNano)
+	far := New(time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC))
+	if _, err := json.Marshal(&far); err == nil {
+		t.Error("json.Marshal() of a time outside the UnixNano range should fail")
+	}
+	if _, err := json.Marshal(&Time{}); err == nil {
+		t.Error("json.Marshal(zero) should fail for UnixNano")
+	}
+}
+
+func TestJSONFormat_String(t *testing.T) {
+	if got := JSONUnixMilliString.String(); got != "UnixMilliString" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := JSONFormat(99).String(); got != "JSONFormat(99)" {
+		t.Errorf("String() = %q", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("SetDefaultJSONFormat(invalid) should panic")
+		}
+	}()
+	SetDefaultJSONFormat(JSONFormat(99))
+}
+
+func TestUnixWrappers(t *testing.T) {
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	type event struct {
+		Seconds UnixSeconds `json:"s"`
+		Millis  UnixMillis  `json:"ms"`
+		Micros  UnixMicros  `json:"us"`
+		Nanos   UnixNanos   `json:"ns"`
+		Default Time        `json:"default"`
+	}
+	in := event{UnixSeconds{ut}, UnixMillis{ut}, UnixMicros{ut}, UnixNanos{ut}, ut}
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	want := `{"s":1704164645,"ms":1704164645123,"us":1704164645123456,"ns":1704164645123456789,"default":"2024-01-02T03:04:05.123456789Z"}`
+	if string(data) != want {
+		t.Fatalf("json.Marshal() = %s, want %s", data, want)
+	}
+
+	var out event
+	if err := json.Unmarshal(data, &out); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	checks := []struct {
+		name string
+		got  Time
+		want time.Time
+	}{
+		{"seconds", out.Seconds.Time, ut.UTC().Truncate(time.Second)},
+		{"millis", out.Millis.Time, ut.UTC().Truncate(time.Millisecond)},
+		{"micros", out.Micros.Time, ut.UTC().Truncate(time.Microsecond)},
+		{"nanos", out.Nanos.Time, ut.UTC()},
+	}
+	for _, c := range checks {
+		if !c.got.UTC().Equal(c.want) {
+			t.Errorf("%s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+
+	var ms UnixMillis
+	for _, input := range []string{`"1704164645123"`, `"2024-01-02T03:04:05.123Z"`} {
+		if err := json.Unmarshal([]byte(input), &ms); err != nil || ms.UnixMilli() != 1704164645123 {
+			t.Errorf("json.Unmarshal(%s) = %v, %v", input, ms, err)
+		}
+	}
+	if err := json.Unmarshal([]byte(`null`), &ms); err != nil || !ms.IsZero() {
+		t.Errorf("json.Unmarshal(null) = %v, %v", ms, err)
+	}
+	if err := json.Unmarshal([]byte(`{}`), &ms); err == nil {
+		t.Error("json.Unmarshal(object) should return error")
+	}
+
+	var (
+		secs   UnixSeconds
+		micros UnixMicros
+		nanos  UnixNanos
+	)
+	bounds := []struct {
+		input   string
+		target  json.Unmarshaler
+		got     *Time
+		want    time.Time
+		wantErr bool
+	}{
+		{`9223372036854775807`, &secs, &secs.Time, time.Time{}, true},
+		{`"-9223372036854775808"`, &secs, &secs.Time, time.Time{}, true},
+		{`9223372036854775807`, &ms, &ms.Time, time.UnixMilli(math.MaxInt64).UTC(), false},
+		{`-9223372036854775808`, &micros, &micros.Time, time.UnixMicro(math.MinInt64).UTC(), false},
+		{`9223372036854775807`, &nanos, &nanos.Time, time.Unix(0, math.MaxInt64).UTC(), false},
+	}
+	for _, b := range bounds {
+		err := json.Unmarshal([]byte(b.input), b.target)
+		if b.wantErr {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("json.Unmarshal(%s, %T) error = %v, want ErrOutOfRange", b.input, b.target, err)
+			}
+			continue
+		}
+		if err != nil || !b.got.UTC().Equal(b.want) {
+			t.Errorf("json.Unmarshal(%s, %T) = %v, %v; want %v", b.input, b.target, b.got, err, b.want)
+		}
+	}
+
+	// The largest UnixNanos instant round-trips exactly.
+	maxNanos := UnixNanos{FromUnixNano(math.MaxInt64)}
+	if data, err := json.Marshal(maxNanos); err != nil || string(data) != `9223372036854775807` {
+		t.Errorf("json.Marshal(max UnixNanos) = %s, %v", data, err)
+	}
+
+	var nilMillis *UnixMillis
+	if err := nilMillis.UnmarshalJSON([]byte(`1`)); err == nil {
+		t.Error("UnmarshalJSON() on nil receiver should return error")
+	}
+}
@@ -0,0 +1,58 @@
+package utc
+
+import "time"
+
+// A Time never carries a monotonic clock reading: New stores t.UTC(), which
+// drops it, so Sub, Since, and Until measure wall-clock time, and two Times
+// for the same instant always compare equal with ==. Use a Stopwatch to
+// measure elapsed time with the monotonic clock.
+
+// StripMonotonic returns t unchanged. A Time has no monotonic clock reading
+// to strip, so the method is a no-op kept for code that mirrors
+// time.Time.Round(0).
+func (t Time) StripMonotonic() Time {
+	return t
+}
+
+// Since returns the wall-clock time elapsed since t. Use a Stopwatch when the
+// result must not jump if the system clock is stepped.
+func Since(t Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// Until returns the wall-clock duration until t.
+func Until(t Time) time.Duration {
+	return t.Sub(Now())
+}
+
+// Stopwatch measures elapsed time with the monotonic clock, so its readings
+// never jump when the wall clock is stepped. Create one with StartStopwatch.
+type Stopwatch struct {
+	start time.Time
+}
+
+// StartStopwatch returns a Stopwatch started now.
+func StartStopwatch() Stopwatch {
+	return Stopwatch{start: time.Now()}
+}
+
+// Started returns the time the stopwatch was started or last restarted, in
+// UTC and without the monotonic reading the stopwatch measures with.
+func (s Stopwatch) Started() Time {
+	return New(s.start)
+}
+
+// Elapsed returns the time elapsed since the stopwatch was started or last
+// restarted.
+func (s Stopwatch) Elapsed() time.Duration {
+	return time.Since(s.start)
+}
+
+// Lap returns the elapsed time and restarts the stopwatch, so consecutive laps
+// add up to the total without gaps.
+func (s *Stopwatch) Lap() time.Duration {
+	now := time.Now()
+	elapsed := now.Sub(s.start)
+	s.start = now
+	return elapsed
+}
@@ -0,0 +1,71 @@
+package utc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStripMonotonic(t *testing.T) {
+	now := Now()
+	if now.StripMonotonic() != now {
+		t.Error("StripMonotonic() changed the value")
+	}
+
+	text, err := now.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Time
+	if err := decoded.UnmarshalText(text); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != now {
+		t.Errorf("decoded Time %v does not compare == to Now() %v", decoded, now)
+	}
+	seen := map[Time]bool{now: true}
+	if !seen[decoded] {
+		t.Error("Now() does not match its decoded copy as a map key")
+	}
+
+	later := now.Add(time.Hour)
+	if d := later.Sub(now); d != time.Hour {
+		t.Errorf("Sub() = %v, want 1h", d)
+	}
+}
+
+func TestSinceUntil(t *testing.T) {
+	start := Now()
+	time.Sleep(time.Millisecond)
+	if d := Since(start); d < time.Millisecond {
+		t.Errorf("Since() = %v, want at least 1ms", d)
+	}
+	if d := Until(start.Add(time.Hour)); d <= 0 || d > time.Hour {
+		t.Errorf("Until(start+1h) = %v", d)
+	}
+	past := New(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	if d := Since(past); d < 20*365*24*time.Hour {
+		t.Errorf("Since(2000) = %v", d)
+	}
+	if d := Until(past); d >= 0 {
+		t.Errorf("Until(2000) = %v, want negative", d)
+	}
+}
+
+func TestStopwatch(t *testing.T) {
+	sw := StartStopwatch()
+	time.Sleep(2 * time.Millisecond)
+	lap := sw.Lap()
+	if lap < 2*time.Millisecond {
+		t.Errorf("Lap() = %v, want at least 2ms", lap)
+	}
+	if e := sw.Elapsed(); e < 0 || e >= lap+time.Second {
+		t.Errorf("Elapsed() after Lap() = %v", e)
+	}
+
+	first := sw.Started()
+	time.Sleep(time.Millisecond)
+	total := lap + sw.Lap()
+	if d := Since(first); total > Since(first)+lap || d < time.Millisecond {
+		t.Errorf("laps add up to %v, Since(first lap end) = %v", total, d)
+	}
+}
@@ -0,0 +1,155 @@
+package utc
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// MessagePack format bytes used by the timestamp extension.
+const (
+	msgpackNil      = 0xc0
+	msgpackExt8     = 0xc7
+	msgpackExt16    = 0xc8
+	msgpackExt32    = 0xc9
+	msgpackFixExt4  = 0xd6
+	msgpackFixExt8  = 0xd7
+	msgpackStr8     = 0xd9
+	msgpackStr16    = 0xda
+	msgpackStr32    = 0xdb
+	msgpackFixStr   = 0xa0 // through 0xbf
+	msgpackTimeType = 0xff // extension type -1
+)
+
+// MarshalMsgpack implements the msgpack.Marshaler interface of
+// github.com/vmihailenco/msgpack and compatible libraries. It encodes t as the
+// MessagePack timestamp extension (type -1) in the shortest of its 32-, 64-,
+// and 96-bit forms, and the zero Time as nil.
+func (t Time) MarshalMsgpack() ([]byte, error) {
+	u := t.utc()
+	if u.IsZero() {
+		return []byte{msgpackNil}, nil
+	}
+	sec, nsec := u.Unix(), uint64(u.Nanosecond())
+	switch {
+	case sec >= 0 && sec>>32 == 0 && nsec == 0:
+		b := []byte{msgpackFixExt4, msgpackTimeType, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(b[2:], uint32(sec))
+		return b, nil
+	case sec >= 0 && sec>>34 == 0:
+		b := []byte{msgpackFixExt8, msgpackTimeType, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.BigEndian.PutUint64(b[2:], nsec<<34|uint64(sec))
+		return b, nil
+	default:
+		b := make([]byte, 15)
+		b[0], b[1], b[2] = msgpackExt8, 12, msgpackTimeType
+		binary.BigEndian.PutUint32(b[3:], uint32(nsec))
+		binary.BigEndian.PutUint64(b[7:], uint64(sec))
+		return b, nil
+	}
+}
+
+// UnmarshalMsgpack implements the msgpack.Unmarshaler interface of
+// github.com/vmihailenco/msgpack and compatible libraries. It accepts the
+// timestamp extension in any of its forms, nil as the zero Time, and strings,
+// which are parsed with the package default parser like UnmarshalText.
+func (t *Time) UnmarshalMsgpack(data []byte) error {
+	if t == nil {
+		debugLog("UnmarshalMsgpack() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal msgpack into nil utc.Time")
+	}
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty msgpack into utc.Time")
+	}
+	if data[0] == msgpackNil && len(data) == 1 {
+		t.t = time.Time{}
+		return nil
+	}
+
+	if s, ok := msgpackString(data); ok {
+		p := currentParser()
+		return p.decodeText(t, s)
+	}
+
+	payload, ok := msgpackTimestamp(data)
+	if !ok {
+		return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal msgpack value with format 0x%02x into utc.Time", data[0]))
+	}
+	var sec, nsec int64
+	switch len(payload) {
+	case 4:
+		sec = int64(binary.BigEndian.Uint32(payload))
+	case 8:
+		v := binary.BigEndian.Uint64(payload)
+		sec, nsec = int64(v&(1<<34-1)), int64(v>>34)
+	case 12:
+		nsec = int64(binary.BigEndian.Uint32(payload))
+		sec = int64(binary.BigEndian.Uint64(payload[4:]))
+	default:
+		return fmt.Errorf("cannot unmarshal msgpack timestamp of %d bytes into utc.Time", len(payload))
+	}
+	if nsec > 999999999 {
+		return fmt.Errorf("msgpack timestamp nanoseconds %d: %w", nsec, ErrOutOfRange)
+	}
+	if sec > maxUnixSec {
+		return fmt.Errorf("msgpack timestamp seconds %d: %w", sec, ErrOutOfRange)
+	}
+	t.t = time.Unix(sec, nsec).UTC()
+	return nil
+}
+
+// msgpackTimestamp returns the payload of data if it is exactly one timestamp
+// extension value.
+func msgpackTimestamp(data []byte) ([]byte, bool) {
+	var n uint64
+	var header int
+	switch data[0] {
+	case msgpackFixExt4:
+		n, header = 4, 1
+	case msgpackFixExt8:
+		n, header = 8, 1
+	case msgpackExt8, msgpackExt16, msgpackExt32:
+		size := 1 << (data[0] - msgpackExt8)
+		if len(data) < 1+size {
+			return nil, false
+		}
+		n, header = msgpackUint(data[1:1+size]), 1+size
+	default:
+		return nil, false
+	}
+	if len(data) <= header || data[header] != msgpackTimeType || uint64(len(data)-header-1) != n {
+		return nil, false
+	}
+	return data[header+1:], true
+}
+
+// msgpackString returns the bytes of data if it is exactly one str value.
+func msgpackString(data []byte) ([]byte, bool) {
+	var n uint64
+	var header int
+	switch c := data[0]; {
+	case c >= msgpackFixStr && c <= msgpackFixStr|0x1f:
+		n, header = uint64(c&0x1f), 1
+	case c >= msgpackStr8 && c <= msgpackStr32:
+		size := 1 << (c - msgpackStr8)
+		if len(data) < 1+size {
+			return nil, false
+		}
+		n, header = msgpackUint(data[1:1+size]), 1+size
+	default:
+		return nil, false
+	}
+	if uint64(len(data)-header) != n {
+		return nil, false
+	}
+	return data[header:], true
+}
+
+// msgpackUint decodes a big-endian unsigned integer of 1, 2, or 4 bytes.
+func msgpackUint(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
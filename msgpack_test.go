@@ -0,0 +1,123 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestTime_Msgpack(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Time
+		hex  string
+	}{
+		{"zero", Time{}, "c0"},
+		{"32-bit", Unix(1, 0), "d6ff00000001"},
+		{"32-bit max", Unix(1<<32-1, 0), "d6ffffffffff"},
+		{"64-bit", Unix(1, 1), "d7ff0000000400000001"},
+		{"64-bit seconds", Unix(1<<32, 0), "d7ff0000000100000000"},
+		{"64-bit max", Unix(1<<34-1, 999999999), "d7ffee6b27ffffffffff"},
+		{"96-bit past", Unix(-1, 0), "c70cff00000000ffffffffffffffff"},
+		{"96-bit future", Unix(1<<34, 5), "c70cff000000050000000400000000"},
+		{"positive infinity", PositiveInfinity, "c70cff3b9ac9ff7ffffff1886e08ff"},
+		{"negative infinity", NegativeInfinity, "c70cff000000008000000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := mustHex(t, tt.hex)
+			got, err := tt.t.MarshalMsgpack()
+			if err != nil {
+				t.Fatalf("MarshalMsgpack() error = %v", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("MarshalMsgpack() = %x, want %x", got, want)
+			}
+			var decoded Time
+			if err := decoded.UnmarshalMsgpack(want); err != nil {
+				t.Fatalf("UnmarshalMsgpack() error = %v", err)
+			}
+			if decoded != tt.t {
+				t.Errorf("UnmarshalMsgpack() = %v, want %v", decoded, tt.t)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalMsgpackForms(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want Time
+	}{
+		{"ext8 with 4 bytes", "c704ff00000001", Unix(1, 0)},
+		{"ext16 with 12 bytes", "c8000cff00000000ffffffffffffffff", Unix(-1, 0)},
+		{"ext32 with 8 bytes", "c900000008ff0000000400000001", Unix(1, 1)},
+		{"fixstr", "b4" + hex.EncodeToString([]byte("2024-01-02T03:04:05Z")), New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))},
+		{"str8 with offset", "d919" + hex.EncodeToString([]byte("2024-01-02T03:04:05+01:00")), New(time.Date(2024, 1, 2, 2, 4, 5, 0, time.UTC))},
+		{"empty string", "a0", Time{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Now()
+			if err := got.UnmarshalMsgpack(mustHex(t, tt.hex)); err != nil {
+				t.Fatalf("UnmarshalMsgpack() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("UnmarshalMsgpack() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalMsgpackErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want error
+	}{
+		{"empty", "", ErrEmpty},
+		{"bool", "c3", ErrUnsupportedType},
+		{"integer", "01", ErrUnsupportedType},
+		{"other ext type", "d60100000001", ErrUnsupportedType},
+		{"trailing bytes", "d6ff0000000100", ErrUnsupportedType},
+		{"truncated", "d7ff00000004", ErrUnsupportedType},
+		{"bad length", "c702ff0000", nil},
+		{"nanoseconds overflow", "c70cff3b9aca000000000000000000", ErrOutOfRange},
+		{"seconds overflow", "c70cff000000007fffffffffffffff", ErrOutOfRange},
+		{"bad string", "a3616263", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := mustHex(t, tt.hex)
+			before := Unix(42, 0)
+			got := before
+			err := got.UnmarshalMsgpack(data)
+			if err == nil {
+				t.Fatalf("UnmarshalMsgpack(%s) = %v, want error", tt.hex, got)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("UnmarshalMsgpack(%s) error = %v, want %v", tt.hex, err, tt.want)
+			}
+			if got != before {
+				t.Errorf("failed UnmarshalMsgpack(%s) modified the receiver", tt.hex)
+			}
+		})
+	}
+
+	var nilTime *Time
+	if err := nilTime.UnmarshalMsgpack([]byte{msgpackNil}); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalMsgpack() error = %v, want ErrNilReceiver", err)
+	}
+}
@@ -0,0 +1,180 @@
+package utc
+
+import (
+	"bytes"
+	"database/sql/driver"
+)
+
+// NullTime represents a Time that may be null. It implements the sql.Scanner
+// interface so it can be used as a scan destination for nullable TIMESTAMP
+// columns, similar to sql.NullTime, and encodes the null state as JSON null,
+// YAML null, and empty text.
+type NullTime struct {
+	Time  Time
+	Valid bool // Valid is true if Time is not NULL
+}
+
+// NullTimeFrom returns a valid NullTime holding t.
+func NullTimeFrom(t Time) NullTime {
+	return NullTime{Time: t, Valid: true}
+}
+
+// NullTimeFromPtr returns a NullTime holding *t, or a null NullTime if t is nil.
+func NullTimeFromPtr(t *Time) NullTime {
+	if t == nil {
+		return NullTime{}
+	}
+	return NullTimeFrom(*t)
+}
+
+// Ptr returns a pointer to the held Time, or nil if n is null.
+func (n NullTime) Ptr() *Time {
+	if !n.Valid {
+		return nil
+	}
+	t := n.Time
+	return &t
+}
+
+// ValueOrZero returns the held Time, or the zero Time if n is null.
+func (n NullTime) ValueOrZero() Time {
+	if !n.Valid {
+		return Time{}
+	}
+	return n.Time
+}
+
+// String implements fmt.Stringer. It prints "null" for a null NullTime.
+func (n NullTime) String() string {
+	if !n.Valid {
+		return "null"
+	}
+	return n.Time.String()
+}
+
+// MarshalJSON implements the json.Marshaler interface for NullTime.
+func (n NullTime) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Time.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for NullTime.
+// JSON null and the empty string produce a null NullTime.
+func (n *NullTime) UnmarshalJSON(data []byte) error {
+	if n == nil {
+		debugLog("UnmarshalJSON() called on nil *NullTime receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.NullTime")
+	}
+	data = bytes.TrimSpace(data)
+
+	if string(data) == "null" || string(data) == `""` {
+		*n = NullTime{}
+		return nil
+	}
+
+	var t Time
+	if err := t.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*n = NullTimeFrom(t)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. A null NullTime encodes as empty text.
+func (n NullTime) MarshalText() ([]byte, error) {
+	if !n.Valid {
+		return []byte{}, nil
+	}
+	return n.Time.MarshalText()
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Empty text produces a null NullTime.
+func (n *NullTime) UnmarshalText(text []byte) error {
+	if n == nil {
+		debugLog("UnmarshalText() called on nil *NullTime receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.NullTime")
+	}
+	if len(text) == 0 {
+		*n = NullTime{}
+		return nil
+	}
+
+	var t Time
+	if err := t.UnmarshalText(text); err != nil {
+		return err
+	}
+	*n = NullTimeFrom(t)
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for NullTime.
+// YAML null and the empty string produce a null NullTime.
+func (n *NullTime) UnmarshalYAML(unmarshal func(any) error) error {
+	if n == nil {
+		debugLog("UnmarshalYAML() called on nil *NullTime receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.NullTime")
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*n = NullTime{}
+		return nil
+	}
+
+	var t Time
+	if err := t.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	*n = NullTimeFrom(t)
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for NullTime. A valid
+// zero Time is written in its text form rather than as null, so it reads back
+// as valid.
+func (n NullTime) MarshalYAML() (any, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	if n.Time.IsZero() {
+		text, err := n.Time.MarshalText()
+		return string(text), err
+	}
+	return n.Time.MarshalYAML()
+}
+
+// Value implements driver.Valuer for database operations.
+// It returns nil for a null NullTime and the UTC time.Time value otherwise.
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Time.Value()
+}
+
+// Scan implements sql.Scanner for database operations.
+// A nil value produces a null NullTime; other values are scanned like Time.
+func (n *NullTime) Scan(value any) error {
+	if n == nil {
+		debugLog("Scan() called on nil *NullTime receiver")
+		return newError(ErrNilReceiver, "cannot scan into nil utc.NullTime")
+	}
+
+	if value == nil {
+		*n = NullTime{}
+		return nil
+	}
+
+	var t Time
+	if err := t.Scan(value); err != nil {
+		return err
+	}
+	*n = NullTimeFrom(t)
+	return nil
+}
@@ -0,0 +1,169 @@
+package utc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNullTime_Constructors(t *testing.T) {
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	n := NullTimeFrom(ut)
+	if !n.Valid || !n.Time.Equal(ut) {
+		t.Errorf("NullTimeFrom() = %+v", n)
+	}
+	if p := n.Ptr(); p == nil || !p.Equal(ut) {
+		t.Errorf("Ptr() = %v, want %v", p, ut)
+	}
+	if got := NullTimeFromPtr(&ut); !got.Valid || !got.Time.Equal(ut) {
+		t.Errorf("NullTimeFromPtr(&ut) = %+v", got)
+	}
+	null := NullTimeFromPtr(nil)
+	if null.Valid || null.Ptr() != nil || !null.ValueOrZero().IsZero() {
+		t.Errorf("NullTimeFromPtr(nil) = %+v", null)
+	}
+	if null.String() != "null" || n.String() != "2024-01-02T03:04:05Z" {
+		t.Errorf("String() = %q / %q", null.String(), n.String())
+	}
+}
+
+func TestNullTime_JSON(t *testing.T) {
+	type record struct {
+		DeletedAt NullTime `json:"deleted_at"`
+	}
+	data, err := json.Marshal(record{})
+	if err != nil || string(data) != `{"deleted_at":null}` {
+		t.Fatalf("json.Marshal(null) = %s, %v", data, err)
+	}
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	data, err = json.Marshal(record{DeletedAt: NullTimeFrom(ut)})
+	if err != nil || string(data) != `{"deleted_at":"2024-01-02T03:04:05.123456789Z"}` {
+		t.Fatalf("json.Marshal(valid) = %s, %v", data, err)
+	}
+
+	tests := []struct {
+		name      string
+		input     string
+		wantValid bool
+		want      time.Time
+		wantErr   bool
+	}{
+		{name: "null", input: `null`},
+		{name: "empty string", input: `""`},
+		{name: "null with whitespace", input: " null\n"},
+		{name: "timestamp", input: `"2024-01-02T03:04:05Z"`, wantValid: true, want: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		{name: "zero timestamp", input: `"0001-01-01T00:00:00Z"`, wantValid: true},
+		{name: "number", input: `123`, wantErr: true},
+		{name: "invalid", input: `"nope"`, wantErr: true},
+		{name: "empty data", input: ``, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := NullTimeFrom(Now())
+			err := n.UnmarshalJSON([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if n.Valid != tt.wantValid {
+				t.Errorf("UnmarshalJSON() Valid = %v, want %v", n.Valid, tt.wantValid)
+			}
+			if n.Valid && !n.Time.UTC().Equal(tt.want) {
+				t.Errorf("UnmarshalJSON() = %v, want %v", n.Time, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullTime_TextAndYAML(t *testing.T) {
+	var null NullTime
+	text, err := null.MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Fatalf("MarshalText(null) = %q, %v", text, err)
+	}
+	n := NullTimeFrom(Now())
+	if err := n.UnmarshalText(text); err != nil || n.Valid {
+		t.Fatalf("UnmarshalText(empty) = %+v, %v", n, err)
+	}
+	if err := n.UnmarshalText([]byte("2024-01-02")); err != nil || !n.Valid {
+		t.Fatalf("UnmarshalText() = %+v, %v", n, err)
+	}
+	if err := n.UnmarshalText([]byte("nope")); err == nil {
+		t.Error("UnmarshalText(invalid) should return error")
+	}
+
+	if marshaled, err := null.MarshalYAML(); err != nil || marshaled != nil {
+		t.Errorf("MarshalYAML(null) = %#v, %v", marshaled, err)
+	}
+	valid := NullTimeFrom(New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)))
+	if marshaled, err := valid.MarshalYAML(); err != nil || marshaled != "2024-01-02T00:00:00Z" {
+		t.Errorf("MarshalYAML(valid) = %#v, %v", marshaled, err)
+	}
+	for _, input := range []string{"", "2024-01-02"} {
+		err := n.UnmarshalYAML(func(v any) error {
+			*(v.(*string)) = input
+			return nil
+		})
+		if err != nil || n.Valid != (input != "") {
+			t.Errorf("UnmarshalYAML(%q) = %+v, %v", input, n, err)
+		}
+	}
+	if err := n.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "nope"
+		return nil
+	}); err == nil {
+		t.Error("UnmarshalYAML(invalid) should return error")
+	}
+
+	// A valid zero Time is not null, so it must not encode as YAML null.
+	validZero := NullTimeFrom(Time{})
+	marshaled, err := validZero.MarshalYAML()
+	if err != nil || marshaled != "0001-01-01T00:00:00Z" {
+		t.Fatalf("MarshalYAML(valid zero) = %#v, %v", marshaled, err)
+	}
+	var back NullTime
+	err = back.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = marshaled.(string)
+		return nil
+	})
+	if err != nil || back != validZero {
+		t.Errorf("UnmarshalYAML(MarshalYAML(valid zero)) = %+v, %v", back, err)
+	}
+}
+
+func TestNullTime_DatabaseOperations(t *testing.T) {
+	var null NullTime
+	value, err := null.Value()
+	if err != nil || value != nil {
+		t.Fatalf("Value(null) = %#v, %v", value, err)
+	}
+	n := NullTimeFrom(Now())
+	if err := n.Scan(nil); err != nil || n.Valid {
+		t.Fatalf("Scan(nil) = %+v, %v", n, err)
+	}
+
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	value, err = NullTimeFrom(New(want)).Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	if err := n.Scan(value); err != nil || !n.Valid || !n.Time.UTC().Equal(want) {
+		t.Fatalf("Scan(value) = %+v, %v", n, err)
+	}
+	if err := n.Scan(42); err == nil {
+		t.Error("Scan(int) should return error")
+	}
+
+	var nilNull *NullTime
+	if err := nilNull.Scan(nil); err == nil {
+		t.Error("Scan() on nil receiver should return error")
+	}
+	if err := nilNull.UnmarshalJSON([]byte(`null`)); err == nil {
+		t.Error("UnmarshalJSON() on nil receiver should return error")
+	}
+	if err := nilNull.UnmarshalText(nil); err == nil {
+		t.Error("UnmarshalText() on nil receiver should return error")
+	}
+}
@@ -0,0 +1,561 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+)
+
+// defaultLayouts are the layouts tried by the package default parser, in order.
+var defaultLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006-01", // YYYY-MM format
+	"2006",    // YYYY format
+}
+
+// DefaultLayouts returns a copy of the layouts tried by a Parser with no
+// Layouts configured, in the order they are tried.
+func DefaultLayouts() []string {
+	out := make([]string, len(defaultLayouts))
+	copy(out, defaultLayouts)
+	return out
+}
+
+// ParseMode selects how strictly a Parser matches its input against layouts.
+type ParseMode int
+
+// Parse modes.
+const (
+	// ParseStrict requires the input to match a layout exactly.
+	ParseStrict ParseMode = iota
+	// ParseLenient trims surrounding whitespace, accepts lowercase 't' and
+	// 'z' designators, and accepts a space in place of the 'T' date/time
+	// separator before matching layouts.
+	ParseLenient
+)
+
+// ZonelessPolicy selects how a Parser treats input whose matching layout
+// carries no zone or offset, such as "2006-01-02 15:04:05".
+type ZonelessPolicy int
+
+// Zone-less input policies.
+const (
+	// ZonelessUseLocation interprets zone-less input as a wall time in the
+	// parser's Location, or in UTC when Location is nil.
+	ZonelessUseLocation ZonelessPolicy = iota
+	// ZonelessReject fails with ErrMissingZone when the matching layout has
+	// no zone or offset.
+	ZonelessReject
+)
+
+// WallTimeError reports zone-less input that cannot be resolved to a single
+// instant. Err is ErrMissingZone, ErrAmbiguousTime, or ErrNonexistentTime.
+type WallTimeError struct {
+	Input    string
+	Layout   string
+	Location *time.Location
+	// Candidates holds both possible instants of an ambiguous wall time,
+	// earlier first. It is empty for the other errors.
+	Candidates []Time
+	Err        error
+}
+
+// Error implements the error interface.
+func (e *WallTimeError) Error() string {
+	if e.Location == nil {
+		return fmt.Sprintf("cannot parse %q as utc.Time: %v", e.Input, e.Err)
+	}
+	return fmt.Sprintf("cannot parse %q as utc.Time: %v in %s", e.Input, e.Err, e.Location)
+}
+
+// Unwrap returns the sentinel error, so callers can use errors.Is.
+func (e *WallTimeError) Unwrap() error {
+	return e.Err
+}
+
+// Parser converts strings to Time values by trying an ordered list of layouts.
+//
+// The zero Parser is ready to use and behaves like the package default: it
+// tries DefaultLayouts in strict mode and interprets zone-less inputs as UTC.
+// To interpret zone-less inputs in another location, set Location; wall times
+// that are ambiguous or skipped there because of a DST transition fail with a
+// *WallTimeError instead of being shifted.
+// Configure a Parser before sharing it between goroutines; its methods do not
+// modify it except Register.
+type Parser struct {
+	// Layouts are tried in order. A nil slice uses DefaultLayouts.
+	Layouts []string
+	// Mode selects strict or lenient matching.
+	Mode ParseMode
+	// Location interprets inputs whose layout carries no zone or offset.
+	// A nil Location is treated as UTC.
+	Location *time.Location
+	// Zoneless selects whether zone-less inputs are accepted at all.
+	Zoneless ZonelessPolicy
+	// Epoch selects whether numeric Unix timestamps are accepted, and in
+	// which unit. Layouts are tried first, so with the default layouts a
+	// four-digit number is still read as a year.
+	Epoch EpochUnit
+}
+
+// NewParser returns a Parser trying the given layouts in order, or
+// DefaultLayouts if none are given.
+func NewParser(layouts ...string) *Parser {
+	if len(layouts) == 0 {
+		return &Parser{Layouts: DefaultLayouts()}
+	}
+	return &Parser{Layouts: append([]string(nil), layouts...)}
+}
+
+// Register appends layouts to the end of the parser's layout list. When no
+// layouts are configured yet, the list starts from DefaultLayouts.
+func (p *Parser) Register(layouts ...string) {
+	p.Layouts = append(p.layouts(), layouts...)
+}
+
+// layouts returns a copy of the configured layouts, falling back to the defaults.
+func (p *Parser) layouts() []string {
+	if p.Layouts == nil {
+		return DefaultLayouts()
+	}
+	return append([]string(nil), p.Layouts...)
+}
+
+// clone returns a deep copy of p so later changes to p do not affect it.
+func (p *Parser) clone() Parser {
+	c := *p
+	if p.Layouts != nil {
+		c.Layouts = append([]string(nil), p.Layouts...)
+	}
+	return c
+}
+
+// Parse parses s by trying each layout in order and returns the first match
+// normalized to UTC. If no layout matches, the error is a *ParseError listing
+// every layout attempted and why it failed.
+func (p *Parser) Parse(s string) (Time, error) {
+	parsed, err := p.parse(s)
+	if err != nil {
+		return Time{}, err
+	}
+	return New(parsed), nil
+}
+
+func (p *Parser) parse(s string) (time.Time, error) {
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	layouts := p.Layouts
+	if layouts == nil {
+		layouts = defaultLayouts
+	}
+
+	inputs := []string{s}
+	shift := 0 // bytes trimmed from the front of s in lenient mode
+	if p.Mode == ParseLenient {
+		inputs = lenientInputs(s)
+		shift = len(s) - len(strings.TrimLeftFunc(s, unicode.IsSpace))
+	}
+	if inf, ok := parseInfinity(inputs[0]); ok {
+		return inf, nil
+	}
+
+	perr := &ParseError{Input: s}
+	for _, input := range inputs {
+		for _, layout := range layouts {
+			if hasZone(layout) {
+				parsed, err := time.ParseInLocation(layout, input, loc)
+				if err == nil {
+					return parsed.UTC(), nil
+				}
+				perr.add(layout, err, shift)
+				continue
+			}
+
+			wall, err := time.Parse(layout, input)
+			if err != nil {
+				perr.add(layout, err, shift)
+				continue
+			}
+			if p.Zoneless == ZonelessReject {
+				return time.Time{}, &WallTimeError{Input: s, Layout: layout, Err: ErrMissingZone}
+			}
+			return resolveWall(s, layout, wall, loc)
+		}
+	}
+	if p.Epoch != EpochNone && isNumber(s) {
+		return parseEpoch(s, p.Epoch)
+	}
+	return time.Time{}, perr
+}
+
+// hasZone reports whether layout contains a zone name or offset element.
+func hasZone(layout string) bool {
+	return strings.Contains(layout, "MST") || strings.Contains(layout, "Z07") || strings.Contains(layout, "-07")
+}
+
+// resolveWall returns the single instant whose clock reading in loc equals
+// the clock reading of wall, which was parsed in UTC.
+func resolveWall(input, layout string, wall time.Time, loc *time.Location) (time.Time, error) {
+	if loc == time.UTC {
+		return wall, nil
+	}
+
+	// Any offset that applies to the wall time is in effect within a day of it.
+	var candidates []Time
+	seen := make(map[int]bool, 3)
+	for _, probe := range []time.Duration{-26 * time.Hour, 0, 26 * time.Hour} {
+		_, offset := wall.Add(probe).In(loc).Zone()
+		if seen[offset] {
+			continue
+		}
+		seen[offset] = true
+		instant := wall.Add(-time.Duration(offset) * time.Second)
+		if _, actual := instant.In(loc).Zone(); actual == offset {
+			candidates = append(candidates, New(instant))
+		}
+	}
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0].utc(), nil
+	case 0:
+		return time.Time{}, &WallTimeError{Input: input, Layout: layout, Location: loc, Err: ErrNonexistentTime}
+	default:
+		sort.Slice(candidates, func(a, b int) bool { return candidates[a].Before(candidates[b]) })
+		return time.Time{}, &WallTimeError{Input: input, Layout: layout, Location: loc, Candidates: candidates, Err: ErrAmbiguousTime}
+	}
+}
+
+// lenientInputs returns the normalized forms of s tried in lenient mode.
+func lenientInputs(s string) []string {
+	b := []byte(strings.TrimSpace(s))
+	if n := len(b); n > 0 && b[n-1] == 'z' {
+		b[n-1] = 'Z'
+	}
+	if len(b) > 10 && b[10] == 't' {
+		b[10] = 'T'
+	}
+	inputs := []string{string(b)}
+	// Accept "2006-01-02 15:04:05Z" for the RFC3339 layouts and
+	// "2006-01-02T15:04:05" for the zone-less layout.
+	if len(b) > 10 && (b[10] == ' ' || b[10] == 'T') {
+		if b[10] == ' ' {
+			b[10] = 'T'
+		} else {
+			b[10] = ' '
+		}
+		inputs = append(inputs, string(b))
+	}
+	return inputs
+}
+
+// LayoutError records why one layout failed to match an input.
+type LayoutError struct {
+	Layout string
+	// Offset is the byte offset in the input at which the layout stopped matching.
+	Offset int
+	Err    error
+}
+
+// ParseError reports that no layout matched an input.
+type ParseError struct {
+	Input string
+	// Offset is the furthest byte offset in Input reached by any layout,
+	// which usually points at the first unexpected character.
+	Offset   int
+	Attempts []LayoutError
+}
+
+// add records a failed attempt of layout. shift is added to offsets reported
+// against a normalized form of the input.
+func (e *ParseError) add(layout string, err error, shift int) {
+	offset := 0
+	var terr *time.ParseError
+	if errors.As(err, &terr) {
+		offset = shift + len(terr.Value) - len(terr.ValueElem)
+	}
+	if offset > len(e.Input) {
+		offset = len(e.Input)
+	}
+	if offset > e.Offset {
+		e.Offset = offset
+	}
+	e.Attempts = append(e.Attempts, LayoutError{Layout: layout, Offset: offset, Err: err})
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	if len(e.Attempts) == 0 {
+		return fmt.Sprintf("cannot parse %q as utc.Time: no layouts configured", e.Input)
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "cannot parse %q as utc.Time at offset %d: tried %d layouts", e.Input, e.Offset, len(e.Attempts))
+	for _, a := range e.Attempts {
+		fmt.Fprintf(&b, "; %q: %v", a.Layout, a.Err)
+	}
+	return b.String()
+}
+
+// Is reports whether target is ErrEmpty and the input was empty.
+func (e *ParseError) Is(target error) bool {
+	return target == ErrEmpty && e.Input == ""
+}
+
+// Unwrap returns the failure of the first layout attempted, so callers can
+// still match the underlying *time.ParseError with errors.As.
+func (e *ParseError) Unwrap() error {
+	if len(e.Attempts) == 0 {
+		return nil
+	}
+	return e.Attempts[0].Err
+}
+
+// Package default parser, used by Time's decoding methods and by the other
+// types that accept timestamps.
+var defaultParser atomic.Value
+
+func init() {
+	defaultParser.Store(Parser{})
+}
+
+// DefaultParser returns a copy of the package default parser.
+func DefaultParser() *Parser {
+	p := currentParser()
+	return &p
+}
+
+// SetDefaultParser replaces the package default parser used by
+// Time.UnmarshalJSON, UnmarshalText, UnmarshalYAML, and Scan. The parser is
+// copied, so later changes to p have no effect. A nil p restores the original
+// defaults. SetDefaultParser is safe to call concurrently with decoding, but
+// is intended to be called once during program initialization.
+func SetDefaultParser(p *Parser) {
+	if p == nil {
+		defaultParser.Store(Parser{})
+		return
+	}
+	defaultParser.Store(p.clone())
+}
+
+func currentParser() Parser {
+	return defaultParser.Load().(Parser)
+}
+
+// parse parses s with the package default parser.
+func parse(s string) (time.Time, error) {
+	p := currentParser()
+	return p.parse(s)
+}
+
+// Into returns a Decoder that decodes into t using p instead of the package
+// default parser. The Decoder implements json.Unmarshaler,
+// encoding.TextUnmarshaler, the yaml.Unmarshaler interface, and sql.Scanner,
+// so it can be passed directly to json.Unmarshal or sql.Rows.Scan.
+func (p *Parser) Into(t *Time) *Decoder {
+	return &Decoder{parser: p.clone(), t: t}
+}
+
+// Decoder decodes into a Time using a specific Parser.
+// Create one with Parser.Into.
+type Decoder struct {
+	parser Parser
+	t      *Time
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Decoder) UnmarshalJSON(data []byte) error {
+	if d == nil || d.t == nil {
+		debugLog("UnmarshalJSON() called on nil *Decoder target")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.Time")
+	}
+	return d.parser.decodeJSON(d.t, data)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *Decoder) UnmarshalText(text []byte) error {
+	if d == nil || d.t == nil {
+		debugLog("UnmarshalText() called on nil *Decoder target")
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.Time")
+	}
+	return d.parser.decodeText(d.t, text)
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (d *Decoder) UnmarshalYAML(unmarshal func(any) error) error {
+	if d == nil || d.t == nil {
+		debugLog("UnmarshalYAML() called on nil *Decoder target")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.Time")
+	}
+	return d.parser.decodeYAML(d.t, unmarshal)
+}
+
+// Scan implements sql.Scanner.
+func (d *Decoder) Scan(value any) error {
+	if d == nil || d.t == nil {
+		debugLog("Scan() called on nil *Decoder target")
+		return newError(ErrNilReceiver, "cannot scan into nil utc.Time")
+	}
+	return d.parser.decodeSQL(d.t, value)
+}
+
+// decodeJSON implements Time.UnmarshalJSON for a non-nil t.
+func (p *Parser) decodeJSON(t *Time, data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	// Handle empty data
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty data into utc.Time")
+	}
+
+	// Handle null
+	if string(data) == "null" {
+		t.t = time.Time{}
+		return nil
+	}
+
+	unit, _, epochFormat := DefaultJSONFormat().epoch()
+	if data[0] != '"' {
+		// Accept the epoch numbers written by a Unix JSON format.
+		if epochFormat {
+			parsed, ok, err := decodeEpochJSON(data, unit)
+			if err != nil {
+				return err
+			}
+			if ok {
+				t.t = parsed
+				return nil
+			}
+		}
+		if p.Epoch != EpochNone && isNumber(string(data)) {
+			parsed, err := parseEpoch(string(data), p.Epoch)
+			if err != nil {
+				return err
+			}
+			t.t = parsed
+			return nil
+		}
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("utc.Time must be a JSON string or null: %w", err)
+	}
+
+	if s == "" {
+		t.t = time.Time{}
+		return nil
+	}
+
+	parsed, err := p.parse(s)
+	if err != nil {
+		// Strings of digits that match no layout may be written by a
+		// quoted Unix JSON format.
+		if epochFormat {
+			if epoch, ok, epochErr := decodeEpochJSON(data, unit); ok {
+				if epochErr != nil {
+					return epochErr
+				}
+				t.t = epoch
+				return nil
+			}
+		}
+		return err
+	}
+	t.t = parsed
+	return nil
+}
+
+// decodeText implements Time.UnmarshalText for a non-nil t.
+func (p *Parser) decodeText(t *Time, text []byte) error {
+	if len(text) == 0 {
+		t.t = time.Time{}
+		return nil
+	}
+	parsed, err := p.parse(string(text))
+	if err != nil {
+		return err
+	}
+	t.t = parsed
+	return nil
+}
+
+// decodeYAML implements Time.UnmarshalYAML for a non-nil t.
+func (p *Parser) decodeYAML(t *Time, unmarshal func(any) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	// Handle empty string
+	if s == "" {
+		t.t = time.Time{}
+		return nil
+	}
+
+	parsed, err := p.parse(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse time %q: %w", s, err)
+	}
+	t.t = parsed
+	return nil
+}
+
+// decodeSQL implements Time.Scan for a non-nil t.
+func (p *Parser) decodeSQL(t *Time, value any) error {
+	if value == nil {
+		return newError(ErrNil, "cannot scan nil into utc.Time")
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		t.t = v.UTC()
+		return nil
+	case string:
+		parsed, err := p.parse(v)
+		if err != nil {
+			return err
+		}
+		t.t = parsed
+		return nil
+	case []byte:
+		parsed, err := p.parse(string(v))
+		if err != nil {
+			return err
+		}
+		t.t = parsed
+		return nil
+	case int64:
+		if p.Epoch == EpochNone {
+			break
+		}
+		parsed, err := parseEpoch(strconv.FormatInt(v, 10), p.Epoch)
+		if err != nil {
+			return err
+		}
+		t.t = parsed
+		return nil
+	case float64:
+		if p.Epoch == EpochNone {
+			break
+		}
+		parsed, err := parseEpoch(strconv.FormatFloat(v, 'f', -1, 64), p.Epoch)
+		if err != nil {
+			return err
+		}
+		t.t = parsed
+		return nil
+	}
+	return newError(ErrUnsupportedType, "cannot scan non-time value into utc.Time")
+}
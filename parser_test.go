@@ -0,0 +1,328 @@
+package utc
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParser_ZeroValueMatchesDefault(t *testing.T) {
+	var p Parser
+	for _, input := range []string{"2024-01-02T03:04:05.123Z", "2024-01-02 03:04:05", "2024-01", "2024"} {
+		got, err := p.Parse(input)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", input, err)
+		}
+		want, _ := parse(input)
+		if !got.UTC().Equal(want) {
+			t.Errorf("Parse(%q) = %v, want %v", input, got, want)
+		}
+	}
+	if got := DefaultLayouts(); len(got) != 6 || got[0] != time.RFC3339Nano {
+		t.Errorf("DefaultLayouts() = %v", got)
+	}
+}
+
+func TestParser_Layouts(t *testing.T) {
+	p := NewParser(string(TimeLayoutUSDateShort))
+	if _, err := p.Parse("2024-01-02"); err == nil {
+		t.Error("Parse() should only try the configured layouts")
+	}
+	got, err := p.Parse("01/02/2024")
+	if err != nil || got.DateOnly() != "2024-01-02" {
+		t.Fatalf("Parse(US date) = %v, %v", got, err)
+	}
+
+	var registered Parser
+	registered.Register(string(TimeLayoutEUDateTime24))
+	if len(registered.Layouts) != len(DefaultLayouts())+1 {
+		t.Fatalf("Register() on zero Parser = %v, want defaults plus one", registered.Layouts)
+	}
+	if got, err := registered.Parse("2024-01-02"); err != nil || got.DateOnly() != "2024-01-02" {
+		t.Errorf("Parse(default layout) = %v, %v", got, err)
+	}
+	if got, err := registered.Parse("02/01/2024 15:04:05"); err != nil || got.RFC3339() != "2024-01-02T15:04:05Z" {
+		t.Errorf("Parse(registered layout) = %v, %v", got, err)
+	}
+
+	// Layouts are tried in order, so an ambiguous input takes the first match.
+	us := NewParser(string(TimeLayoutUSDateShort), string(TimeLayoutEUDateShort))
+	if got, _ := us.Parse("03/04/2024"); got.DateOnly() != "2024-03-04" {
+		t.Errorf("ordered Parse() = %v, want US interpretation", got)
+	}
+	eu := NewParser(string(TimeLayoutEUDateShort), string(TimeLayoutUSDateShort))
+	if got, _ := eu.Parse("03/04/2024"); got.DateOnly() != "2024-04-03" {
+		t.Errorf("ordered Parse() = %v, want EU interpretation", got)
+	}
+}
+
+func TestParser_Modes(t *testing.T) {
+	inputs := []string{
+		" 2024-01-02T03:04:05Z ",
+		"2024-01-02t03:04:05z",
+		"2024-01-02 03:04:05Z",
+		"2024-01-02T03:04:05",
+	}
+	strict := Parser{Mode: ParseStrict}
+	lenient := Parser{Mode: ParseLenient}
+	want := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	for _, input := range inputs {
+		if _, err := strict.Parse(input); err == nil {
+			t.Errorf("strict Parse(%q) unexpectedly succeeded", input)
+		}
+		got, err := lenient.Parse(input)
+		if err != nil || !got.UTC().Equal(want) {
+			t.Errorf("lenient Parse(%q) = %v, %v; want %v", input, got, err, want)
+		}
+	}
+	if _, err := lenient.Parse("not a date"); err == nil {
+		t.Error("lenient Parse() of garbage should fail")
+	}
+}
+
+func TestParser_Location(t *testing.T) {
+	p := Parser{Location: easternLocation}
+	got, err := p.Parse("2024-01-02 09:00:00")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if want := time.Date(2024, 1, 2, 14, 0, 0, 0, time.UTC); !got.UTC().Equal(want) {
+		t.Errorf("Parse(zone-less) = %v, want %v", got, want)
+	}
+	// Explicit offsets win over Location.
+	got, err = p.Parse("2024-01-02T09:00:00Z")
+	if err != nil || got.UTC().Hour() != 9 {
+		t.Errorf("Parse(with offset) = %v, %v", got, err)
+	}
+}
+
+func TestParser_ParseErrorListsAttempts(t *testing.T) {
+	p := NewParser(time.RFC3339, string(TimeLayoutDateOnly))
+	_, err := p.Parse("nope")
+	var perr *ParseError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Parse() error = %T, want *ParseError", err)
+	}
+	if perr.Input != "nope" || len(perr.Attempts) != 2 {
+		t.Fatalf("ParseError = %+v", perr)
+	}
+	for k, layout := range []string{time.RFC3339, string(TimeLayoutDateOnly)} {
+		if perr.Attempts[k].Layout != layout || perr.Attempts[k].Err == nil {
+			t.Errorf("Attempts[%d] = %+v", k, perr.Attempts[k])
+		}
+		if !strings.Contains(err.Error(), layout) {
+			t.Errorf("Error() = %q does not mention %q", err.Error(), layout)
+		}
+	}
+	var tperr *time.ParseError
+	if !errors.As(err, &tperr) {
+		t.Error("ParseError should unwrap to the first *time.ParseError")
+	}
+
+	_, err = (&Parser{Layouts: []string{}}).Parse("2024")
+	if err == nil || !strings.Contains(err.Error(), "no layouts configured") {
+		t.Errorf("Parse() with no layouts error = %v", err)
+	}
+}
+
+func TestParser_DefaultParser(t *testing.T) {
+	t.Cleanup(func() { SetDefaultParser(nil) })
+
+	p := NewParser()
+	p.Register(string(TimeLayoutUSDateShort))
+	SetDefaultParser(p)
+	p.Layouts = nil // later changes must not leak into the package default
+
+	var ut Time
+	if err := json.Unmarshal([]byte(`"01/02/2024"`), &ut); err != nil || ut.DateOnly() != "2024-01-02" {
+		t.Fatalf("UnmarshalJSON() with custom default = %v, %v", ut, err)
+	}
+	if err := ut.UnmarshalText([]byte("12/31/2023")); err != nil || ut.DateOnly() != "2023-12-31" {
+		t.Fatalf("UnmarshalText() with custom default = %v, %v", ut, err)
+	}
+	if err := ut.Scan("06/15/2024"); err != nil || ut.DateOnly() != "2024-06-15" {
+		t.Fatalf("Scan() with custom default = %v, %v", ut, err)
+	}
+	if got := DefaultParser(); len(got.Layouts) != len(DefaultLayouts())+1 {
+		t.Errorf("DefaultParser() = %+v", got)
+	}
+
+	SetDefaultParser(nil)
+	if err := ut.UnmarshalText([]byte("12/31/2023")); err == nil {
+		t.Error("SetDefaultParser(nil) should restore the default layouts")
+	}
+}
+
+func TestParser_Decoder(t *testing.T) {
+	p := &Parser{Layouts: []string{string(TimeLayoutEUDateShort)}, Location: pacificLocation}
+
+	var ut Time
+	if err := json.Unmarshal([]byte(`"31/12/2023"`), p.Into(&ut)); err != nil {
+		t.Fatalf("json.Unmarshal(Decoder) error = %v", err)
+	}
+	if want := time.Date(2023, 12, 31, 8, 0, 0, 0, time.UTC); !ut.UTC().Equal(want) {
+		t.Errorf("json.Unmarshal(Decoder) = %v, want %v", ut, want)
+	}
+	if err := json.Unmarshal([]byte(`null`), p.Into(&ut)); err != nil || !ut.IsZero() {
+		t.Errorf("json.Unmarshal(null) = %v, %v", ut, err)
+	}
+	if err := p.Into(&ut).UnmarshalText([]byte("01/02/2024")); err != nil || ut.DateOnly() != "2024-02-01" {
+		t.Errorf("UnmarshalText() = %v, %v", ut, err)
+	}
+	err := p.Into(&ut).UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "15/06/2024"
+		return nil
+	})
+	if err != nil || ut.DateOnly() != "2024-06-15" {
+		t.Errorf("UnmarshalYAML() = %v, %v", ut, err)
+	}
+
+	var scanner sql.Scanner = p.Into(&ut)
+	if err := scanner.Scan([]byte("01/03/2024")); err != nil || ut.DateOnly() != "2024-03-01" {
+		t.Errorf("Scan() = %v, %v", ut, err)
+	}
+	if err := scanner.Scan("2024-03-01"); err == nil {
+		t.Error("Scan() should use only the configured layouts")
+	}
+	if err := scanner.Scan(time.Date(2024, 3, 1, 0, 0, 0, 0, pacificLocation)); err != nil || ut.UTC().Hour() != 8 {
+		t.Errorf("Scan(time.Time) = %v, %v", ut, err)
+	}
+	if err := scanner.Scan(nil); err == nil {
+		t.Error("Scan(nil) should return error")
+	}
+
+	nilTarget := p.Into(nil)
+	if err := nilTarget.UnmarshalJSON([]byte(`null`)); err == nil {
+		t.Error("UnmarshalJSON() into nil target should return error")
+	}
+	if err := nilTarget.UnmarshalText(nil); err == nil {
+		t.Error("UnmarshalText() into nil target should return error")
+	}
+	if err := nilTarget.UnmarshalYAML(func(any) error { return nil }); err == nil {
+		t.Error("UnmarshalYAML() into nil target should return error")
+	}
+	if err := nilTarget.Scan("01/01/2024"); err == nil {
+		t.Error("Scan() into nil target should return error")
+	}
+}
+
+func TestParser_ZonelessPolicy(t *testing.T) {
+	tests := []struct {
+		name      string
+		parser    Parser
+		input     string
+		want      time.Time
+		wantErr   error
+		wantCands []time.Time
+	}{
+		{
+			name:   "default assumes UTC",
+			input:  "2024-01-02 15:04:05",
+			want:   time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+			parser: Parser{},
+		},
+		{
+			name:   "location",
+			parser: Parser{Location: easternLocation},
+			input:  "2024-07-02 15:04:05",
+			want:   time.Date(2024, 7, 2, 19, 4, 5, 0, time.UTC),
+		},
+		{
+			name:   "location ignored when offset present",
+			parser: Parser{Location: easternLocation},
+			input:  "2024-07-02T15:04:05+02:00",
+			want:   time.Date(2024, 7, 2, 13, 4, 5, 0, time.UTC),
+		},
+		{
+			name:   "just before fall back",
+			parser: Parser{Location: easternLocation},
+			input:  "2024-11-03 00:59:59",
+			want:   time.Date(2024, 11, 3, 4, 59, 59, 0, time.UTC),
+		},
+		{
+			name:      "ambiguous fall back",
+			parser:    Parser{Location: easternLocation},
+			input:     "2024-11-03 01:30:00",
+			wantErr:   ErrAmbiguousTime,
+			wantCands: []time.Time{time.Date(2024, 11, 3, 5, 30, 0, 0, time.UTC), time.Date(2024, 11, 3, 6, 30, 0, 0, time.UTC)},
+		},
+		{
+			name:    "nonexistent spring forward",
+			parser:  Parser{Location: easternLocation},
+			input:   "2024-03-10 02:30:00",
+			wantErr: ErrNonexistentTime,
+		},
+		{
+			name:    "reject",
+			parser:  Parser{Zoneless: ZonelessReject},
+			input:   "2024-01-02 15:04:05",
+			wantErr: ErrMissingZone,
+		},
+		{
+			name:    "reject date only",
+			parser:  Parser{Zoneless: ZonelessReject},
+			input:   "2024-01-02",
+			wantErr: ErrMissingZone,
+		},
+		{
+			name:   "reject accepts offsets",
+			parser: Parser{Zoneless: ZonelessReject},
+			input:  "2024-01-02T15:04:05Z",
+			want:   time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.parser.Parse(tt.input)
+			if tt.wantErr == nil {
+				if err != nil || !got.UTC().Equal(tt.want) {
+					t.Fatalf("Parse(%q) = %v, %v; want %v", tt.input, got, err, tt.want)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Parse(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+			}
+			var werr *WallTimeError
+			if !errors.As(err, &werr) || werr.Input != tt.input {
+				t.Fatalf("Parse(%q) error = %#v, want *WallTimeError", tt.input, err)
+			}
+			if len(werr.Candidates) != len(tt.wantCands) {
+				t.Fatalf("Candidates = %v, want %v", werr.Candidates, tt.wantCands)
+			}
+			for k, want := range tt.wantCands {
+				if !werr.Candidates[k].UTC().Equal(want) {
+					t.Errorf("Candidates[%d] = %v, want %v", k, werr.Candidates[k], want)
+				}
+			}
+		})
+	}
+}
+
+func TestParser_ZonelessDecoding(t *testing.T) {
+	t.Cleanup(func() { SetDefaultParser(nil) })
+
+	p := DefaultParser()
+	p.Zoneless = ZonelessReject
+	SetDefaultParser(p)
+
+	var ut Time
+	if err := json.Unmarshal([]byte(`"2024-01-02 15:04:05"`), &ut); !errors.Is(err, ErrMissingZone) {
+		t.Errorf("UnmarshalJSON() error = %v, want ErrMissingZone", err)
+	}
+	if err := ut.Scan("2024-01-02T15:04:05Z"); err != nil {
+		t.Errorf("Scan() error = %v", err)
+	}
+
+	// A per-decoder parser overrides the package policy.
+	eastern := &Parser{Location: easternLocation}
+	if err := eastern.Into(&ut).Scan("2024-01-02 15:04:05"); err != nil || ut.UTC().Hour() != 20 {
+		t.Errorf("Decoder.Scan() = %v, %v", ut, err)
+	}
+	if err := eastern.Into(&ut).UnmarshalText([]byte("2024-03-10 02:30:00")); !errors.Is(err, ErrNonexistentTime) {
+		t.Errorf("Decoder.UnmarshalText() error = %v, want ErrNonexistentTime", err)
+	}
+}
@@ -0,0 +1,362 @@
+package utc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Period is a calendar-aware amount of time expressed as an ISO 8601 duration,
+// such as "P1Y2M10DT2H30M".
+//
+// Unlike time.Duration, the year, month, and day components have no fixed
+// length: they are applied on the calendar by Time.AddPeriod. Components may
+// be negative. Nanoseconds holds the fractional part of Seconds and should
+// share its sign.
+type Period struct {
+	Years       int
+	Months      int
+	Days        int
+	Hours       int
+	Minutes     int
+	Seconds     int
+	Nanoseconds int
+}
+
+// PeriodFromDuration returns a Period holding d as hours, minutes, seconds,
+// and nanoseconds. Applying it adds exactly d.
+func PeriodFromDuration(d time.Duration) Period {
+	return Period{
+		Hours:       int(d / time.Hour),
+		Minutes:     int(d % time.Hour / time.Minute),
+		Seconds:     int(d % time.Minute / time.Second),
+		Nanoseconds: int(d % time.Second),
+	}
+}
+
+// ParsePeriod parses an ISO 8601 duration such as "P1Y2M10DT2H30M" or "PT0.5S".
+//
+// A leading '-' negates every component. Weeks ("P2W") are converted to days
+// and may be combined with other components. Only the seconds component may
+// carry a fraction, using either '.' or ',' as the decimal separator.
+func ParsePeriod(s string) (Period, error) {
+	var p Period
+	rest := s
+	negative := false
+	if strings.HasPrefix(rest, "-") || strings.HasPrefix(rest, "+") {
+		negative = rest[0] == '-'
+		rest = rest[1:]
+	}
+	if !strings.HasPrefix(rest, "P") {
+		return Period{}, fmt.Errorf("utc.Period %q must start with 'P'", s)
+	}
+	rest = rest[1:]
+
+	datePart, timePart, hasTime := strings.Cut(rest, "T")
+	if datePart == "" && timePart == "" {
+		return Period{}, fmt.Errorf("utc.Period %q has no components", s)
+	}
+	if hasTime && timePart == "" {
+		return Period{}, fmt.Errorf("utc.Period %q has no time components after 'T'", s)
+	}
+
+	var weeks int
+	dateFields := []periodField{{'Y', &p.Years}, {'M', &p.Months}, {'W', &weeks}, {'D', &p.Days}}
+	if err := parsePeriodFields(s, datePart, dateFields, nil); err != nil {
+		return Period{}, err
+	}
+	timeFields := []periodField{{'H', &p.Hours}, {'M', &p.Minutes}, {'S', &p.Seconds}}
+	if err := parsePeriodFields(s, timePart, timeFields, &p.Nanoseconds); err != nil {
+		return Period{}, err
+	}
+	p.Days += 7 * weeks
+
+	if negative {
+		p = p.Negate()
+	}
+	return p, nil
+}
+
+// periodField binds an ISO 8601 designator to the component it sets.
+type periodField struct {
+	designator byte
+	value      *int
+}
+
+// parsePeriodFields parses the designated numbers in part, which must appear in
+// the order given by fields. A fraction is accepted only on the last field and
+// only when nanos is non-nil.
+func parsePeriodFields(input, part string, fields []periodField, nanos *int) error {
+	next := 0
+	for part != "" {
+		end := strings.IndexFunc(part, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '-' && r != '+' && r != '.' && r != ','
+		})
+		if end <= 0 {
+			return fmt.Errorf("utc.Period %q has a malformed component near %q", input, part)
+		}
+		number, designator := part[:end], part[end]
+		part = part[end+1:]
+
+		k := next
+		for k < len(fields) && fields[k].designator != designator {
+			k++
+		}
+		if k == len(fields) {
+			return fmt.Errorf("utc.Period %q has unexpected or out-of-order designator %q", input, designator)
+		}
+		next = k + 1
+
+		whole, fraction, hasFraction := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+		if hasFraction && (nanos == nil || k != len(fields)-1) {
+			return fmt.Errorf("utc.Period %q allows a fraction only on seconds", input)
+		}
+		v, err := strconv.Atoi(whole)
+		if err != nil {
+			return fmt.Errorf("utc.Period %q has invalid number %q: %w", input, number, err)
+		}
+		*fields[k].value = v
+		if hasFraction {
+			ns, err := parseFraction(fraction)
+			if err != nil {
+				return fmt.Errorf("utc.Period %q has invalid fraction %q: %w", input, number, err)
+			}
+			if strings.HasPrefix(whole, "-") {
+				ns = -ns
+			}
+			*nanos = ns
+		}
+	}
+	return nil
+}
+
+// parseFraction converts the digits after a decimal point into nanoseconds,
+// truncating digits beyond nanosecond precision.
+func parseFraction(digits string) (int, error) {
+	if digits == "" {
+		return 0, errors.New("missing digits")
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, errors.New("non-digit in fraction")
+		}
+	}
+	if len(digits) > 9 {
+		digits = digits[:9]
+	}
+	ns, _ := strconv.Atoi(digits + strings.Repeat("0", 9-len(digits)))
+	return ns, nil
+}
+
+// IsZero reports whether every component of p is zero.
+func (p Period) IsZero() bool {
+	return p == Period{}
+}
+
+// Negate returns p with every component negated.
+func (p Period) Negate() Period {
+	return Period{
+		Years:       -p.Years,
+		Months:      -p.Months,
+		Days:        -p.Days,
+		Hours:       -p.Hours,
+		Minutes:     -p.Minutes,
+		Seconds:     -p.Seconds,
+		Nanoseconds: -p.Nanoseconds,
+	}
+}
+
+// ClockDuration returns the fixed-length part of p (hours, minutes, seconds,
+// and nanoseconds) as a time.Duration. Years, months, and days are ignored
+// because their length depends on the calendar.
+func (p Period) ClockDuration() time.Duration {
+	return time.Duration(p.Hours)*time.Hour +
+		time.Duration(p.Minutes)*time.Minute +
+		time.Duration(p.Seconds)*time.Second +
+		time.Duration(p.Nanoseconds)
+}
+
+// String implements fmt.Stringer. It formats p as an ISO 8601 duration.
+// The zero Period is "PT0S". When every non-zero component is negative the
+// sign is written once in front, as in "-P1DT2H".
+func (p Period) String() string {
+	if p.IsZero() {
+		return "PT0S"
+	}
+	var b strings.Builder
+	if p.isNegative() {
+		b.WriteByte('-')
+		p = p.Negate()
+	}
+	b.WriteByte('P')
+	writePeriodField(&b, p.Years, 'Y')
+	writePeriodField(&b, p.Months, 'M')
+	writePeriodField(&b, p.Days, 'D')
+	if p.Hours != 0 || p.Minutes != 0 || p.Seconds != 0 || p.Nanoseconds != 0 {
+		b.WriteByte('T')
+		writePeriodField(&b, p.Hours, 'H')
+		writePeriodField(&b, p.Minutes, 'M')
+		if p.Nanoseconds != 0 {
+			b.WriteString(formatSeconds(p.Seconds, p.Nanoseconds))
+			b.WriteByte('S')
+		} else {
+			writePeriodField(&b, p.Seconds, 'S')
+		}
+	}
+	return b.String()
+}
+
+// isNegative reports whether p has at least one negative and no positive components.
+func (p Period) isNegative() bool {
+	values := []int{p.Years, p.Months, p.Days, p.Hours, p.Minutes, p.Seconds, p.Nanoseconds}
+	negative := false
+	for _, v := range values {
+		if v > 0 {
+			return false
+		}
+		negative = negative || v < 0
+	}
+	return negative
+}
+
+func writePeriodField(b *strings.Builder, v int, designator byte) {
+	if v == 0 {
+		return
+	}
+	b.WriteString(strconv.Itoa(v))
+	b.WriteByte(designator)
+}
+
+// formatSeconds formats seconds with a trimmed nine-digit fraction. sec and
+// nsec may have different signs; they are normalized to their sum first.
+func formatSeconds(sec, nsec int) string {
+	sec += nsec / int(time.Second)
+	nsec %= int(time.Second)
+	switch {
+	case sec > 0 && nsec < 0:
+		sec--
+		nsec += int(time.Second)
+	case sec < 0 && nsec > 0:
+		sec++
+		nsec -= int(time.Second)
+	}
+
+	sign := ""
+	if sec < 0 || nsec < 0 {
+		sign = "-"
+	}
+	if sec < 0 {
+		sec = -sec
+	}
+	if nsec < 0 {
+		nsec = -nsec
+	}
+	fraction := strings.TrimRight(fmt.Sprintf("%09d", nsec), "0")
+	if fraction == "" {
+		return sign + strconv.Itoa(sec)
+	}
+	return sign + strconv.Itoa(sec) + "." + fraction
+}
+
+// MarshalJSON implements the json.Marshaler interface for Period.
+func (p Period) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Period.
+// JSON null and the empty string set the zero Period.
+func (p *Period) UnmarshalJSON(data []byte) error {
+	if p == nil {
+		debugLog("UnmarshalJSON() called on nil *Period receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.Period")
+	}
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty data into utc.Period")
+	}
+
+	if string(data) == "null" {
+		*p = Period{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("utc.Period must be a JSON string or null: %w", err)
+	}
+
+	return p.set(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (p Period) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Period) UnmarshalText(text []byte) error {
+	if p == nil {
+		debugLog("UnmarshalText() called on nil *Period receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.Period")
+	}
+	return p.set(string(text))
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for Period.
+func (p *Period) UnmarshalYAML(unmarshal func(any) error) error {
+	if p == nil {
+		debugLog("UnmarshalYAML() called on nil *Period receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.Period")
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	if err := p.set(s); err != nil {
+		return fmt.Errorf("failed to parse period %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for Period.
+// The zero Period is a meaningful amount, so it is emitted as "PT0S" rather than null.
+func (p Period) MarshalYAML() (any, error) {
+	return p.String(), nil
+}
+
+// set parses s into p. An empty string sets the zero Period.
+func (p *Period) set(s string) error {
+	if s == "" {
+		*p = Period{}
+		return nil
+	}
+	parsed, err := ParsePeriod(s)
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// AddPeriod returns t with p applied on the UTC calendar.
+//
+// Years and months are applied first, clamping the day to the end of the
+// resulting month, so January 31 plus one month is the last day of February
+// rather than early March. Days are then added as calendar days and the clock
+// components as a fixed duration. Use Add for a purely fixed time.Duration.
+// PositiveInfinity and NegativeInfinity are returned unchanged.
+func (t Time) AddPeriod(p Period) Time {
+	if t.IsInfinite() {
+		return t
+	}
+	u, _ := addMonths(t.utc(), 12*p.Years+p.Months, OverflowClamp)
+	u = u.AddDate(0, 0, p.Days)
+	return New(u.Add(p.ClockDuration()))
+}
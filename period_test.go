@@ -0,0 +1,221 @@
+package utc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestPeriod_Parse(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Period
+		wantErr bool
+	}{
+		{input: "P1Y2M10DT2H30M", want: Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}},
+		{input: "PT0S", want: Period{}},
+		{input: "P3W", want: Period{Days: 21}},
+		{input: "P1W2D", want: Period{Days: 9}},
+		{input: "PT1M", want: Period{Minutes: 1}},
+		{input: "P1M", want: Period{Months: 1}},
+		{input: "PT0.5S", want: Period{Nanoseconds: 500000000}},
+		{input: "PT1,25S", want: Period{Seconds: 1, Nanoseconds: 250000000}},
+		{input: "PT1.0000000019S", want: Period{Seconds: 1, Nanoseconds: 1}},
+		{input: "-P1DT2H", want: Period{Days: -1, Hours: -2}},
+		{input: "+P1D", want: Period{Days: 1}},
+		{input: "P-1Y2M", want: Period{Years: -1, Months: 2}},
+		{input: "PT-1.5S", want: Period{Seconds: -1, Nanoseconds: -500000000}},
+		{input: "", wantErr: true},
+		{input: "P", wantErr: true},
+		{input: "PT", wantErr: true},
+		{input: "P1DT", wantErr: true},
+		{input: "1Y", wantErr: true},
+		{input: "P1H", wantErr: true},
+		{input: "PT1Y", wantErr: true},
+		{input: "P2D1Y", wantErr: true},
+		{input: "P1.5Y", wantErr: true},
+		{input: "PT1.S", wantErr: true},
+		{input: "PY", wantErr: true},
+		{input: "P1", wantErr: true},
+		{input: "P99999999999999999999Y", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePeriod(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePeriod(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("ParsePeriod(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPeriod_String(t *testing.T) {
+	tests := []struct {
+		period Period
+		want   string
+		parsed Period // what want parses back to, when it differs from period
+	}{
+		{period: Period{}, want: "PT0S"},
+		{period: Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}, want: "P1Y2M10DT2H30M"},
+		{period: Period{Seconds: 1, Nanoseconds: 250000000}, want: "PT1.25S"},
+		{period: Period{Nanoseconds: 1}, want: "PT0.000000001S"},
+		{period: Period{Days: -1, Hours: -2}, want: "-P1DT2H"},
+		{period: Period{Years: -1, Months: 2}, want: "P-1Y2M"},
+		{period: Period{Seconds: -1, Nanoseconds: -500000000}, want: "-PT1.5S"},
+		{period: Period{Seconds: 1, Nanoseconds: -500000000}, want: "PT0.5S", parsed: Period{Nanoseconds: 500000000}},
+		{period: Period{Seconds: -2, Nanoseconds: 500000000}, want: "PT-1.5S", parsed: Period{Seconds: -1, Nanoseconds: -500000000}},
+		{period: Period{Hours: 1, Seconds: 2, Nanoseconds: -2500000000}, want: "PT1H-0.5S", parsed: Period{Hours: 1, Nanoseconds: -500000000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.period.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			want := tt.period
+			if tt.parsed != (Period{}) {
+				want = tt.parsed
+			}
+			parsed, err := ParsePeriod(tt.want)
+			if err != nil || parsed != want {
+				t.Errorf("ParsePeriod(String()) = %+v, %v; want %+v", parsed, err, want)
+			}
+		})
+	}
+}
+
+func TestPeriod_Helpers(t *testing.T) {
+	d := 26*time.Hour + 3*time.Minute + 4*time.Second + 5*time.Millisecond
+	p := PeriodFromDuration(d)
+	if p != (Period{Hours: 26, Minutes: 3, Seconds: 4, Nanoseconds: 5000000}) {
+		t.Errorf("PeriodFromDuration() = %+v", p)
+	}
+	if p.ClockDuration() != d {
+		t.Errorf("ClockDuration() = %v, want %v", p.ClockDuration(), d)
+	}
+	if p.Negate().ClockDuration() != -d {
+		t.Errorf("Negate().ClockDuration() = %v, want %v", p.Negate().ClockDuration(), -d)
+	}
+	if !(Period{}).IsZero() || p.IsZero() {
+		t.Error("IsZero() failed")
+	}
+	if (Period{Days: 1, Hours: 1}).ClockDuration() != time.Hour {
+		t.Error("ClockDuration() must ignore calendar components")
+	}
+}
+
+func TestTime_AddPeriod(t *testing.T) {
+	tests := []struct {
+		name   string
+		start  time.Time
+		period Period
+		want   time.Time
+	}{
+		{
+			name:   "month end clamps",
+			start:  time.Date(2024, 1, 31, 10, 0, 0, 0, time.UTC),
+			period: Period{Months: 1},
+			want:   time.Date(2024, 2, 29, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "leap day plus a year clamps",
+			start:  time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+			period: Period{Years: 1},
+			want:   time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "negative months clamp",
+			start:  time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+			period: Period{Months: -1},
+			want:   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "months before days",
+			start:  time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			period: Period{Months: 1, Days: 1},
+			want:   time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "full period",
+			start:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			period: Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30, Seconds: 1, Nanoseconds: 5},
+			want:   time.Date(2025, 3, 11, 2, 30, 1, 5, time.UTC),
+		},
+		{
+			name:   "zero period",
+			start:  time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			period: Period{},
+			want:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.start).AddPeriod(tt.period)
+			if !got.UTC().Equal(tt.want) {
+				t.Errorf("AddPeriod(%v) = %v, want %v", tt.period, got, tt.want)
+			}
+		})
+	}
+
+	// Add with a fixed duration overflows instead of clamping.
+	jan31 := New(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC))
+	if got := jan31.Add(30 * 24 * time.Hour); got.UTC().Month() != time.March {
+		t.Errorf("Add(30 days) = %v, want March", got)
+	}
+}
+
+func TestPeriod_Encoding(t *testing.T) {
+	type job struct {
+		Every Period `json:"every"`
+	}
+	data, err := json.Marshal(job{Every: Period{Days: 1, Hours: 12}})
+	if err != nil || string(data) != `{"every":"P1DT12H"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+	var decoded job
+	if err := json.Unmarshal([]byte(`{"every":"P1Y2M10DT2H30M"}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if decoded.Every != (Period{Years: 1, Months: 2, Days: 10, Hours: 2, Minutes: 30}) {
+		t.Errorf("json.Unmarshal() = %+v", decoded.Every)
+	}
+	for _, input := range []string{``, `5`, `"5 days"`} {
+		var p Period
+		if err := p.UnmarshalJSON([]byte(input)); err == nil {
+			t.Errorf("UnmarshalJSON(%q) should return error", input)
+		}
+	}
+	p := Period{Days: 1}
+	if err := p.UnmarshalJSON([]byte(`null`)); err != nil || !p.IsZero() {
+		t.Errorf("UnmarshalJSON(null) = %+v, %v", p, err)
+	}
+
+	text, err := (Period{Minutes: 15}).MarshalText()
+	if err != nil || string(text) != "PT15M" {
+		t.Fatalf("MarshalText() = %q, %v", text, err)
+	}
+	if err := p.UnmarshalText(text); err != nil || p != (Period{Minutes: 15}) {
+		t.Errorf("UnmarshalText() = %+v, %v", p, err)
+	}
+	if err := p.UnmarshalText(nil); err != nil || !p.IsZero() {
+		t.Errorf("UnmarshalText(empty) = %+v, %v", p, err)
+	}
+
+	if marshaled, err := (Period{}).MarshalYAML(); err != nil || marshaled != "PT0S" {
+		t.Errorf("MarshalYAML(zero) = %#v, %v", marshaled, err)
+	}
+	err = p.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "P1W"
+		return nil
+	})
+	if err != nil || p != (Period{Days: 7}) {
+		t.Errorf("UnmarshalYAML() = %+v, %v", p, err)
+	}
+
+	var nilPeriod *Period
+	if err := nilPeriod.UnmarshalText([]byte("P1D")); err == nil {
+		t.Error("UnmarshalText() on nil receiver should return error")
+	}
+}
@@ -0,0 +1,75 @@
+package utc
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Fixed-precision RFC 3339 layouts. Unlike time.RFC3339Nano they always
+// write the same number of fractional digits.
+const (
+	rfc3339Milli = "2006-01-02T15:04:05.000Z07:00"
+	rfc3339Micro = "2006-01-02T15:04:05.000000Z07:00"
+)
+
+// Precision is the smallest unit of time a value keeps, such as the
+// microseconds stored by PostgreSQL or the milliseconds of MySQL DATETIME(3).
+type Precision time.Duration
+
+// Common precisions.
+const (
+	Nanosecond  = Precision(time.Nanosecond)
+	Microsecond = Precision(time.Microsecond)
+	Millisecond = Precision(time.Millisecond)
+	Second      = Precision(time.Second)
+)
+
+// String implements fmt.Stringer.
+func (p Precision) String() string {
+	switch p {
+	case Nanosecond:
+		return "Nanosecond"
+	case Microsecond:
+		return "Microsecond"
+	case Millisecond:
+		return "Millisecond"
+	case Second:
+		return "Second"
+	}
+	return fmt.Sprintf("Precision(%s)", time.Duration(p))
+}
+
+// TruncateTo returns t with everything finer than p dropped, so that a value
+// stored at precision p reads back Equal to it.
+func (t Time) TruncateTo(p Precision) Time {
+	return t.Truncate(time.Duration(p))
+}
+
+// RoundTo returns t rounded to the nearest multiple of p, rounding halfway
+// values up, as PostgreSQL and MySQL do when storing fractional seconds.
+func (t Time) RoundTo(p Precision) Time {
+	return t.Round(time.Duration(p))
+}
+
+var valuePrecision int64 // Precision
+
+// ValuePrecision returns the precision applied by Time.Value.
+// Zero means values are passed to the driver with full nanosecond precision.
+func ValuePrecision() Precision {
+	return Precision(atomic.LoadInt64(&valuePrecision))
+}
+
+// SetValuePrecision makes Time.Value, and NullTime.Value for valid values,
+// truncate to p before handing the value to the database driver. Databases
+// round rather than truncate extra digits, so without it a value written and
+// read back can differ from the original in its last stored digit. A p of
+// zero or less restores full precision. SetValuePrecision is safe to call
+// concurrently with Value, but is intended to be called once during program
+// initialization.
+func SetValuePrecision(p Precision) {
+	if p < 0 {
+		p = 0
+	}
+	atomic.StoreInt64(&valuePrecision, int64(p))
+}
@@ -0,0 +1,90 @@
+package utc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPrecision_TruncateAndRound(t *testing.T) {
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	tests := []struct {
+		precision    Precision
+		wantTruncate time.Time
+		wantRound    time.Time
+	}{
+		{Nanosecond, ut.UTC(), ut.UTC()},
+		{Microsecond, time.Date(2024, 1, 2, 3, 4, 5, 123456000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 123457000, time.UTC)},
+		{Millisecond, time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 123000000, time.UTC)},
+		{Second, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.precision.String(), func(t *testing.T) {
+			if got := ut.TruncateTo(tt.precision); !got.UTC().Equal(tt.wantTruncate) {
+				t.Errorf("TruncateTo() = %v, want %v", got, tt.wantTruncate)
+			}
+			if got := ut.RoundTo(tt.precision); !got.UTC().Equal(tt.wantRound) {
+				t.Errorf("RoundTo() = %v, want %v", got, tt.wantRound)
+			}
+		})
+	}
+
+	half := New(time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC))
+	if got := half.RoundTo(Second); got.UTC().Second() != 6 {
+		t.Errorf("RoundTo(Second) of a half second = %v, want rounded up", got)
+	}
+	if got := Precision(250 * time.Millisecond).String(); got != "Precision(250ms)" {
+		t.Errorf("String() = %q", got)
+	}
+}
+
+func TestPrecision_Formats(t *testing.T) {
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 120000000, time.UTC))
+	if got := ut.RFC3339Milli(); got != "2024-01-02T03:04:05.120Z" {
+		t.Errorf("RFC3339Milli() = %q", got)
+	}
+	if got := ut.RFC3339Micro(); got != "2024-01-02T03:04:05.120000Z" {
+		t.Errorf("RFC3339Micro() = %q", got)
+	}
+	whole := New(time.Date(2024, 1, 2, 3, 4, 5, 999999, time.UTC))
+	if got := whole.RFC3339Milli(); got != "2024-01-02T03:04:05.000Z" {
+		t.Errorf("RFC3339Milli() = %q, want truncated digits", got)
+	}
+	if parsed, err := ParseRFC3339(whole.RFC3339Micro()); err != nil || !parsed.Equal(whole.TruncateTo(Microsecond)) {
+		t.Errorf("ParseRFC3339(RFC3339Micro()) = %v, %v", parsed, err)
+	}
+}
+
+func TestPrecision_Value(t *testing.T) {
+	t.Cleanup(func() { SetValuePrecision(0) })
+
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	value, err := ut.Value()
+	if err != nil || !value.(time.Time).Equal(ut.UTC()) {
+		t.Fatalf("Value() with full precision = %v, %v", value, err)
+	}
+
+	SetValuePrecision(Microsecond)
+	if got := ValuePrecision(); got != Microsecond {
+		t.Fatalf("ValuePrecision() = %v", got)
+	}
+	value, err = ut.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var scanned Time
+	if err := scanned.Scan(value); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if !scanned.Equal(ut.TruncateTo(Microsecond)) {
+		t.Errorf("Scan(Value()) = %v, want %v", scanned, ut.TruncateTo(Microsecond))
+	}
+	value, err = NullTimeFrom(ut).Value()
+	if err != nil || !value.(time.Time).Equal(ut.UTC().Truncate(time.Microsecond)) {
+		t.Errorf("NullTime.Value() = %v, %v", value, err)
+	}
+
+	SetValuePrecision(-Second)
+	if got := ValuePrecision(); got != 0 {
+		t.Errorf("ValuePrecision() after negative = %v, want 0", got)
+	}
+}
@@ -0,0 +1,292 @@
+package utc
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// oneDay is the length of a calendar day without DST transitions.
+const oneDay = 24 * time.Hour
+
+// timeOfDayLayout formats a TimeOfDay with sub-second precision only when present.
+const timeOfDayLayout = "15:04:05.999999999"
+
+// TimeOfDay stores a wall-clock time (hour, minute, second, nanosecond) without a date.
+//
+// Values are always in the range [00:00:00, 24:00:00). The zero value is midnight.
+type TimeOfDay struct {
+	d time.Duration
+}
+
+// NewTimeOfDay returns the TimeOfDay for the given clock values.
+// Out-of-range values are normalized and wrap around midnight.
+func NewTimeOfDay(hour, minute, sec, nsec int) TimeOfDay {
+	return timeOfDay(time.Duration(hour)*time.Hour +
+		time.Duration(minute)*time.Minute +
+		time.Duration(sec)*time.Second +
+		time.Duration(nsec))
+}
+
+// TimeOfDayOf returns the UTC wall-clock time of t.
+func TimeOfDayOf(t Time) TimeOfDay {
+	return clockOf(t.utc())
+}
+
+// ParseTimeOfDay parses a wall-clock time. It accepts TimeLayoutTimeOnly
+// ("15:04:05", with optional fractional seconds), "15:04", TimeLayoutUSTime12
+// ("3:04 PM"), "3:04:05 PM", and time.Kitchen ("3:04PM").
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	tryLayouts := []string{
+		string(TimeLayoutTimeOnly),
+		string(TimeLayoutUSTime24),
+		string(TimeLayoutUSTime12),
+		"3:04:05 PM",
+		time.Kitchen,
+	}
+	var firstErr error
+	for _, layout := range tryLayouts {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			return clockOf(parsed), nil
+		} else if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return TimeOfDay{}, firstErr
+}
+
+// timeOfDay wraps d into the range [0, 24h).
+func timeOfDay(d time.Duration) TimeOfDay {
+	d %= oneDay
+	if d < 0 {
+		d += oneDay
+	}
+	return TimeOfDay{d: d}
+}
+
+func clockOf(t time.Time) TimeOfDay {
+	h, m, s := t.Clock()
+	return NewTimeOfDay(h, m, s, t.Nanosecond())
+}
+
+// Hour returns the hour of t, in the range [0, 23].
+func (t TimeOfDay) Hour() int {
+	return int(t.d / time.Hour)
+}
+
+// Minute returns the minute of t, in the range [0, 59].
+func (t TimeOfDay) Minute() int {
+	return int(t.d % time.Hour / time.Minute)
+}
+
+// Second returns the second of t, in the range [0, 59].
+func (t TimeOfDay) Second() int {
+	return int(t.d % time.Minute / time.Second)
+}
+
+// Nanosecond returns the nanosecond offset within the second, in the range [0, 999999999].
+func (t TimeOfDay) Nanosecond() int {
+	return int(t.d % time.Second)
+}
+
+// SinceMidnight returns the duration elapsed since midnight.
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return t.d
+}
+
+// Add returns t+d, wrapping around midnight in either direction.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	return timeOfDay(t.d + d%oneDay)
+}
+
+// Sub returns the duration t-u. The result is negative when t is earlier in the day than u.
+func (t TimeOfDay) Sub(u TimeOfDay) time.Duration {
+	return t.d - u.d
+}
+
+// Before reports whether t is earlier in the day than u
+func (t TimeOfDay) Before(u TimeOfDay) bool {
+	return t.d < u.d
+}
+
+// After reports whether t is later in the day than u
+func (t TimeOfDay) After(u TimeOfDay) bool {
+	return t.d > u.d
+}
+
+// Equal reports whether t and u are the same wall-clock time
+func (t TimeOfDay) Equal(u TimeOfDay) bool {
+	return t.d == u.d
+}
+
+// Compare returns -1 if t is before u, 0 if they are equal, and +1 if t is after u.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch {
+	case t.d < u.d:
+		return -1
+	case t.d > u.d:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// IsZero reports whether t is midnight.
+func (t TimeOfDay) IsZero() bool {
+	return t.d == 0
+}
+
+// On returns the UTC instant at wall-clock time t on date d.
+func (t TimeOfDay) On(d Date) Time {
+	return New(d.t.Add(t.d))
+}
+
+// OnDateOf returns the UTC instant at wall-clock time t on the UTC calendar date of u.
+func (t TimeOfDay) OnDateOf(u Time) Time {
+	return t.On(DateOf(u))
+}
+
+// In returns the instant at wall-clock time t on date d in loc.
+// A nil loc is treated as UTC.
+func (t TimeOfDay) In(d Date, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, dd := d.Date()
+	return time.Date(y, m, dd, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// String implements fmt.Stringer. It prints the time as "15:04:05" followed by
+// fractional seconds when they are non-zero.
+func (t TimeOfDay) String() string {
+	return t.Format(timeOfDayLayout)
+}
+
+// Format formats the time using the specified layout. Date elements in the
+// layout are rendered from January 1, year 1.
+func (t TimeOfDay) Format(layout string) string {
+	return time.Time{}.Add(t.d).Format(layout)
+}
+
+// TimeFormat formats the time using the specified layout
+func (t TimeOfDay) TimeFormat(layout TimeLayout) string {
+	return t.Format(string(layout))
+}
+
+// MarshalJSON implements the json.Marshaler interface for TimeOfDay.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for TimeOfDay.
+// JSON null and the empty string set midnight.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	if t == nil {
+		debugLog("UnmarshalJSON() called on nil *TimeOfDay receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.TimeOfDay")
+	}
+	data = bytes.TrimSpace(data)
+
+	if len(data) == 0 {
+		return newError(ErrEmpty, "cannot unmarshal empty data into utc.TimeOfDay")
+	}
+
+	if string(data) == "null" {
+		t.d = 0
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("utc.TimeOfDay must be a JSON string or null: %w", err)
+	}
+
+	return t.set(s)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	if t == nil {
+		debugLog("UnmarshalText() called on nil *TimeOfDay receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.TimeOfDay")
+	}
+	return t.set(string(text))
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface for TimeOfDay.
+func (t *TimeOfDay) UnmarshalYAML(unmarshal func(any) error) error {
+	if t == nil {
+		debugLog("UnmarshalYAML() called on nil *TimeOfDay receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.TimeOfDay")
+	}
+
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	if err := t.set(s); err != nil {
+		return fmt.Errorf("failed to parse time of day %q: %w", s, err)
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface for TimeOfDay.
+// Midnight is a meaningful wall-clock time, so it is emitted rather than null.
+func (t TimeOfDay) MarshalYAML() (any, error) {
+	return t.String(), nil
+}
+
+// Value implements driver.Valuer for database operations.
+// It returns the time as a "15:04:05.999999999" string suitable for TIME columns.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	return t.String(), nil
+}
+
+// Scan implements sql.Scanner for database operations.
+// It accepts time.Time, string, and []byte values. For time.Time values the
+// wall clock is taken in the value's own location, since drivers commonly
+// return TIME columns as a time on a placeholder date.
+func (t *TimeOfDay) Scan(value any) error {
+	if t == nil {
+		debugLog("Scan() called on nil *TimeOfDay receiver")
+		return newError(ErrNilReceiver, "cannot scan into nil utc.TimeOfDay")
+	}
+
+	if value == nil {
+		return newError(ErrNil, "cannot scan nil into utc.TimeOfDay")
+	}
+
+	switch v := value.(type) {
+	case time.Time:
+		*t = clockOf(v)
+		return nil
+	case string:
+		return t.set(v)
+	case []byte:
+		return t.set(string(v))
+	default:
+		return newError(ErrUnsupportedType, "cannot scan non-time value into utc.TimeOfDay")
+	}
+}
+
+// set parses s into t. An empty string sets midnight.
+func (t *TimeOfDay) set(s string) error {
+	if s == "" {
+		t.d = 0
+		return nil
+	}
+	parsed, err := ParseTimeOfDay(s)
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
@@ -0,0 +1,203 @@
+package utc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeOfDay_Constructors(t *testing.T) {
+	tod := NewTimeOfDay(15, 4, 5, 123000000)
+	if tod.Hour() != 15 || tod.Minute() != 4 || tod.Second() != 5 || tod.Nanosecond() != 123000000 {
+		t.Errorf("NewTimeOfDay() = %v", tod)
+	}
+	if got := NewTimeOfDay(25, 0, 0, 0); !got.Equal(NewTimeOfDay(1, 0, 0, 0)) {
+		t.Errorf("NewTimeOfDay(25, 0, 0, 0) = %v, want 01:00:00", got)
+	}
+	if got := NewTimeOfDay(0, -1, 0, 0); got.String() != "23:59:00" {
+		t.Errorf("NewTimeOfDay(0, -1, 0, 0) = %v, want 23:59:00", got)
+	}
+	ut := New(time.Date(2024, 1, 2, 10, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+	if got := TimeOfDayOf(ut); got.String() != "15:30:00" {
+		t.Errorf("TimeOfDayOf() = %v, want UTC wall clock 15:30:00", got)
+	}
+	if got := NewTimeOfDay(1, 2, 3, 0).SinceMidnight(); got != time.Hour+2*time.Minute+3*time.Second {
+		t.Errorf("SinceMidnight() = %v", got)
+	}
+}
+
+func TestTimeOfDay_Parse(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    TimeOfDay
+		wantErr bool
+	}{
+		{name: "time only", input: "15:04:05", want: NewTimeOfDay(15, 4, 5, 0)},
+		{name: "fractional", input: "15:04:05.999", want: NewTimeOfDay(15, 4, 5, 999000000)},
+		{name: "hours and minutes", input: "09:30", want: NewTimeOfDay(9, 30, 0, 0)},
+		{name: "US 12 hour", input: "3:04 PM", want: NewTimeOfDay(15, 4, 0, 0)},
+		{name: "US 12 hour with seconds", input: "12:00:01 AM", want: NewTimeOfDay(0, 0, 1, 0)},
+		{name: "kitchen", input: "9:15AM", want: NewTimeOfDay(9, 15, 0, 0)},
+		{name: "out of range", input: "24:00:00", wantErr: true},
+		{name: "garbage", input: "noon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTimeOfDay(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTimeOfDay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("ParseTimeOfDay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimeOfDay_Format(t *testing.T) {
+	tod := NewTimeOfDay(15, 4, 5, 120000000)
+	if got := tod.String(); got != "15:04:05.12" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := NewTimeOfDay(8, 0, 0, 0).String(); got != "08:00:00" {
+		t.Errorf("String() = %q", got)
+	}
+	if got := tod.TimeFormat(TimeLayoutUSTime12); got != "3:04 PM" {
+		t.Errorf("TimeFormat(US12) = %q", got)
+	}
+	if got := tod.Format(time.Kitchen); got != "3:04PM" {
+		t.Errorf("Format(Kitchen) = %q", got)
+	}
+}
+
+func TestTimeOfDay_Arithmetic(t *testing.T) {
+	late := NewTimeOfDay(23, 30, 0, 0)
+	if got := late.Add(time.Hour); got.String() != "00:30:00" {
+		t.Errorf("Add(1h) = %v, want 00:30:00", got)
+	}
+	if got := late.Add(-24*time.Hour - time.Hour); got.String() != "22:30:00" {
+		t.Errorf("Add(-25h) = %v, want 22:30:00", got)
+	}
+	early := NewTimeOfDay(0, 30, 0, 0)
+	if got := early.Sub(late); got != -23*time.Hour {
+		t.Errorf("Sub() = %v, want -23h", got)
+	}
+	if !early.Before(late) || !late.After(early) || early.Equal(late) {
+		t.Error("comparisons failed")
+	}
+	if early.Compare(late) != -1 || late.Compare(early) != 1 || early.Compare(early) != 0 {
+		t.Error("Compare() failed")
+	}
+	if !(TimeOfDay{}).IsZero() || early.IsZero() {
+		t.Error("IsZero() failed")
+	}
+}
+
+func TestTimeOfDay_Combine(t *testing.T) {
+	tod := NewTimeOfDay(9, 0, 0, 0)
+	d := NewDate(2024, 3, 10)
+	want := time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC)
+	if got := tod.On(d); !got.UTC().Equal(want) {
+		t.Errorf("On() = %v, want %v", got, want)
+	}
+	ut := New(time.Date(2024, 3, 10, 22, 0, 0, 0, time.UTC))
+	if got := tod.OnDateOf(ut); !got.UTC().Equal(want) {
+		t.Errorf("OnDateOf() = %v, want %v", got, want)
+	}
+	// 2024-03-10 is the US spring-forward day; 09:00 Pacific is PDT (UTC-7).
+	if got := tod.In(d, pacificLocation); !got.Equal(time.Date(2024, 3, 10, 16, 0, 0, 0, time.UTC)) {
+		t.Errorf("In(Pacific) = %v", got.UTC())
+	}
+	if got := tod.In(d, nil); !got.Equal(want) {
+		t.Errorf("In(nil) = %v, want %v", got, want)
+	}
+}
+
+func TestTimeOfDay_Encoding(t *testing.T) {
+	type schedule struct {
+		Opens TimeOfDay `json:"opens"`
+	}
+	data, err := json.Marshal(schedule{Opens: NewTimeOfDay(9, 30, 0, 0)})
+	if err != nil || string(data) != `{"opens":"09:30:00"}` {
+		t.Fatalf("json.Marshal() = %s, %v", data, err)
+	}
+	var decoded schedule
+	if err := json.Unmarshal([]byte(`{"opens":"5:45 PM"}`), &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if !decoded.Opens.Equal(NewTimeOfDay(17, 45, 0, 0)) {
+		t.Errorf("json.Unmarshal() = %v", decoded.Opens)
+	}
+	for _, input := range []string{``, `930`, `"25:00"`} {
+		var tod TimeOfDay
+		if err := tod.UnmarshalJSON([]byte(input)); err == nil {
+			t.Errorf("UnmarshalJSON(%q) should return error", input)
+		}
+	}
+	tod := NewTimeOfDay(1, 0, 0, 0)
+	if err := tod.UnmarshalJSON([]byte(`null`)); err != nil || !tod.IsZero() {
+		t.Errorf("UnmarshalJSON(null) = %v, %v", tod, err)
+	}
+
+	text, err := NewTimeOfDay(9, 30, 0, 0).MarshalText()
+	if err != nil || string(text) != "09:30:00" {
+		t.Fatalf("MarshalText() = %q, %v", text, err)
+	}
+	if err := tod.UnmarshalText(text); err != nil || tod.String() != "09:30:00" {
+		t.Errorf("UnmarshalText() = %v, %v", tod, err)
+	}
+
+	marshaled, err := (TimeOfDay{}).MarshalYAML()
+	if err != nil || marshaled != "00:00:00" {
+		t.Errorf("MarshalYAML(midnight) = %#v, %v", marshaled, err)
+	}
+	err = tod.UnmarshalYAML(func(v any) error {
+		*(v.(*string)) = "18:00"
+		return nil
+	})
+	if err != nil || tod.String() != "18:00:00" {
+		t.Errorf("UnmarshalYAML() = %v, %v", tod, err)
+	}
+}
+
+func TestTimeOfDay_DatabaseOperations(t *testing.T) {
+	tod := NewTimeOfDay(15, 4, 5, 500000)
+	value, err := tod.Value()
+	if err != nil || value != "15:04:05.0005" {
+		t.Fatalf("Value() = %#v, %v", value, err)
+	}
+
+	tests := []struct {
+		name    string
+		input   any
+		want    TimeOfDay
+		wantErr bool
+	}{
+		{name: "value round trip", input: value, want: tod},
+		{name: "bytes", input: []byte("08:00:00"), want: NewTimeOfDay(8, 0, 0, 0)},
+		{name: "placeholder date", input: time.Date(0, 1, 1, 8, 0, 0, 0, time.UTC), want: NewTimeOfDay(8, 0, 0, 0)},
+		{name: "nil", input: nil, wantErr: true},
+		{name: "unsupported", input: int64(8), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got TimeOfDay
+			err := got.Scan(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	var nilTOD *TimeOfDay
+	if err := nilTOD.Scan("08:00"); err == nil {
+		t.Error("Scan() on nil receiver should return error")
+	}
+	if err := nilTOD.UnmarshalJSON([]byte(`"08:00"`)); err == nil {
+		t.Error("UnmarshalJSON() on nil receiver should return error")
+	}
+}
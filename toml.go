@@ -0,0 +1,81 @@
+package utc
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Layouts of the TOML local date-time and local date, used to format wall
+// times for the parser and in its errors.
+const (
+	tomlLocalDatetime = "2006-01-02T15:04:05.999999999"
+	tomlLocalDate     = "2006-01-02"
+)
+
+// MarshalTOML implements the toml.Marshaler interface of
+// github.com/BurntSushi/toml. It writes t as a native TOML offset date-time
+// in UTC, such as 1979-05-27T07:32:00.5Z, rather than a quoted string.
+// PositiveInfinity and NegativeInfinity, which TOML date-times cannot
+// represent, are written as the strings "infinity" and "-infinity".
+func (t Time) MarshalTOML() ([]byte, error) {
+	if inf, ok := t.infinityString(); ok {
+		return []byte(strconv.Quote(inf)), nil
+	}
+	return t.MarshalText()
+}
+
+// UnmarshalTOML implements the toml.Unmarshaler interface of
+// github.com/BurntSushi/toml. It accepts offset date-times, and strings,
+// which are parsed with the package default parser like UnmarshalText.
+// Local date-times and local dates carry no offset, so they are resolved by
+// the default parser's Location and Zoneless policy, as zone-less text is.
+// Local times have no date and are rejected.
+//
+// github.com/pelletier/go-toml/v2 does not use this method: it passes the
+// text of each date-time to UnmarshalText.
+func (t *Time) UnmarshalTOML(v any) error {
+	if t == nil {
+		debugLog("UnmarshalTOML() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal TOML into nil utc.Time")
+	}
+	p := currentParser()
+
+	switch v := v.(type) {
+	case string:
+		return p.decodeText(t, []byte(v))
+	case time.Time:
+		// BurntSushi/toml marks local values with these fixed zones.
+		switch v.Location().String() {
+		case "datetime-local":
+			return p.decodeWall(t, tomlLocalDatetime, v)
+		case "date-local":
+			return p.decodeWall(t, tomlLocalDate, v)
+		case "time-local":
+			return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal TOML local time %s into utc.Time", v.Format("15:04:05.999999999")))
+		}
+		t.t = v.UTC()
+		return nil
+	}
+	return newError(ErrUnsupportedType, fmt.Sprintf("cannot unmarshal TOML %T into utc.Time", v))
+}
+
+// decodeWall sets t from the wall clock reading of v, which has no
+// meaningful zone, using the parser's Location and Zoneless policy.
+func (p *Parser) decodeWall(t *Time, layout string, v time.Time) error {
+	input := v.Format(layout)
+	if p.Zoneless == ZonelessReject {
+		return &WallTimeError{Input: input, Layout: layout, Err: ErrMissingZone}
+	}
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	wall := time.Date(v.Year(), v.Month(), v.Day(), v.Hour(), v.Minute(), v.Second(), v.Nanosecond(), time.UTC)
+	resolved, err := resolveWall(input, layout, wall, loc)
+	if err != nil {
+		return err
+	}
+	t.t = resolved
+	return nil
+}
@@ -0,0 +1,120 @@
+package utc
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTime_MarshalTOML(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Time
+		want string
+	}{
+		{"zero", Time{}, "0001-01-01T00:00:00Z"},
+		{"whole seconds", Unix(296638320, 0), "1979-05-27T07:32:00Z"},
+		{"fraction", Unix(296638320, 999999000), "1979-05-27T07:32:00.999999Z"},
+		{"positive infinity", PositiveInfinity, `"infinity"`},
+		{"negative infinity", NegativeInfinity, `"-infinity"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.t.MarshalTOML()
+			if err != nil || string(got) != tt.want {
+				t.Errorf("MarshalTOML() = %s, %v, want %s", got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := New(time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)).MarshalTOML(); err == nil {
+		t.Error("MarshalTOML() of year 10000 succeeded")
+	}
+}
+
+func TestTime_UnmarshalTOML(t *testing.T) {
+	localDatetime := time.FixedZone("datetime-local", 3600)
+	localDate := time.FixedZone("date-local", 3600)
+	tests := []struct {
+		name string
+		v    any
+		want Time
+	}{
+		{"offset datetime", time.Date(1979, 5, 27, 0, 32, 0, 999999000, time.FixedZone("", -7*3600)), Unix(296638320, 999999000)},
+		{"local datetime", time.Date(1979, 5, 27, 7, 32, 0, 0, localDatetime), Unix(296638320, 0)},
+		{"local date", time.Date(1979, 5, 27, 0, 0, 0, 0, localDate), New(time.Date(1979, 5, 27, 0, 0, 0, 0, time.UTC))},
+		{"string", "1979-05-27T07:32:00Z", Unix(296638320, 0)},
+		{"empty string", "", Time{}},
+		{"infinity", "infinity", PositiveInfinity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Now()
+			if err := got.UnmarshalTOML(tt.v); err != nil {
+				t.Fatalf("UnmarshalTOML() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("UnmarshalTOML() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTime_UnmarshalTOMLParser(t *testing.T) {
+	t.Cleanup(func() { SetDefaultParser(nil) })
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	local := time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("datetime-local", 0))
+
+	SetDefaultParser(&Parser{Location: newYork})
+	var got Time
+	if err := got.UnmarshalTOML(local); err != nil || got != New(time.Date(2024, 1, 2, 8, 4, 5, 0, time.UTC)) {
+		t.Errorf("UnmarshalTOML() in New York = %v, %v", got, err)
+	}
+	gap := time.Date(2024, 3, 10, 2, 30, 0, 0, time.FixedZone("datetime-local", 0))
+	if err := got.UnmarshalTOML(gap); !errors.Is(err, ErrNonexistentTime) {
+		t.Errorf("UnmarshalTOML() of a skipped wall time error = %v, want ErrNonexistentTime", err)
+	}
+
+	SetDefaultParser(&Parser{Zoneless: ZonelessReject})
+	var wtErr *WallTimeError
+	if err := got.UnmarshalTOML(local); !errors.As(err, &wtErr) || !errors.Is(err, ErrMissingZone) || wtErr.Input != "2024-01-02T03:04:05" {
+		t.Errorf("UnmarshalTOML() with ZonelessReject error = %v, want ErrMissingZone", err)
+	}
+}
+
+func TestTime_UnmarshalTOMLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want error
+	}{
+		{"local time", time.Date(0, 1, 1, 7, 32, 0, 0, time.FixedZone("time-local", 0)), ErrUnsupportedType},
+		{"integer", int64(296638320), ErrUnsupportedType},
+		{"table", map[string]any{}, ErrUnsupportedType},
+		{"unparseable string", "yesterday", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := Unix(42, 0)
+			got := before
+			err := got.UnmarshalTOML(tt.v)
+			if err == nil {
+				t.Fatalf("UnmarshalTOML() = %v, want error", got)
+			}
+			if tt.want != nil && !errors.Is(err, tt.want) {
+				t.Errorf("UnmarshalTOML() error = %v, want %v", err, tt.want)
+			}
+			if got != before {
+				t.Error("failed UnmarshalTOML() modified the receiver")
+			}
+		})
+	}
+
+	var nilTime *Time
+	if err := nilTime.UnmarshalTOML("2024-01-02T03:04:05Z"); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalTOML() error = %v, want ErrNilReceiver", err)
+	}
+}
@@ -0,0 +1,126 @@
+package utc
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// unixToInternal is the number of seconds between year 1 and the Unix epoch,
+// the offset time.Time adds to Unix seconds in its internal representation.
+const unixToInternal int64 = (1969*365 + 1969/4 - 1969/100 + 1969/400) * 86400
+
+// Range of Unix seconds that time.Time represents without wrapping.
+const (
+	minUnixSec int64 = math.MinInt64
+	maxUnixSec int64 = math.MaxInt64 - unixToInternal
+)
+
+// Unix returns the Time sec seconds and nsec nanoseconds after the Unix
+// epoch, like time.Unix. nsec may lie outside [0, 999999999].
+// Inputs beyond the range of time.Time wrap; use UnixChecked to detect them.
+func Unix(sec, nsec int64) Time {
+	return New(time.Unix(sec, nsec))
+}
+
+// UnixChecked is like Unix but returns ErrOutOfRange instead of wrapping
+// when the instant cannot be represented.
+func UnixChecked(sec, nsec int64) (Time, error) {
+	// Normalize nsec into [0, 1e9) without overflowing sec.
+	carry, rem := nsec/int64(time.Second), nsec%int64(time.Second)
+	if rem < 0 {
+		rem += int64(time.Second)
+		carry--
+	}
+	if (carry > 0 && sec > maxUnixSec-carry) || (carry <= 0 && sec < minUnixSec-carry) || sec+carry > maxUnixSec {
+		return Time{}, fmt.Errorf("Unix(%d, %d): %w", sec, nsec, ErrOutOfRange)
+	}
+	return New(time.Unix(sec+carry, rem)), nil
+}
+
+// FromUnixMicro returns the Time us microseconds after the Unix epoch.
+// Every int64 input is representable.
+func FromUnixMicro(us int64) Time {
+	return New(time.UnixMicro(us))
+}
+
+// FromUnixNano returns the Time ns nanoseconds after the Unix epoch.
+// Every int64 input is representable.
+func FromUnixNano(ns int64) Time {
+	return New(time.Unix(0, ns))
+}
+
+// FromUnixFloat returns the Time sec seconds after the Unix epoch, rounded to
+// the nearest nanosecond. A float64 holds about 16 significant digits, so
+// present-day values are precise to roughly a microsecond. It returns the zero
+// Time when sec is NaN, infinite, or out of range; use FromUnixFloatChecked to
+// detect those inputs.
+func FromUnixFloat(sec float64) Time {
+	t, _ := FromUnixFloatChecked(sec)
+	return t
+}
+
+// FromUnixFloatChecked is like FromUnixFloat but returns ErrOutOfRange when
+// sec is NaN, infinite, or out of range.
+func FromUnixFloatChecked(sec float64) (Time, error) {
+	if math.IsNaN(sec) || sec < float64(minUnixSec) || sec >= float64(maxUnixSec) {
+		return Time{}, fmt.Errorf("FromUnixFloat(%v): %w", sec, ErrOutOfRange)
+	}
+	whole := math.Floor(sec)
+	return New(time.Unix(int64(whole), int64(math.Round((sec-whole)*1e9)))), nil
+}
+
+// UnixMicro returns t as the number of microseconds since the Unix epoch.
+// The result is undefined if it does not fit in an int64; use
+// UnixMicroChecked to detect that.
+func (t Time) UnixMicro() int64 {
+	return t.utc().UnixMicro()
+}
+
+// UnixNano returns t as the number of nanoseconds since the Unix epoch.
+// The result is undefined for instants before 1678 or after 2262, including
+// the zero Time; use UnixNanoChecked to detect that.
+func (t Time) UnixNano() int64 {
+	return t.utc().UnixNano()
+}
+
+// UnixFloat returns t as fractional seconds since the Unix epoch. Precision
+// beyond about a microsecond is lost for present-day values.
+func (t Time) UnixFloat() float64 {
+	u := t.utc()
+	return float64(u.Unix()) + float64(u.Nanosecond())/1e9
+}
+
+// UnixMilliChecked is like UnixMilli but returns ErrOutOfRange when the
+// result does not fit in an int64.
+func (t Time) UnixMilliChecked() (int64, error) {
+	return t.unixChecked(time.Millisecond)
+}
+
+// UnixMicroChecked is like UnixMicro but returns ErrOutOfRange when the
+// result does not fit in an int64.
+func (t Time) UnixMicroChecked() (int64, error) {
+	return t.unixChecked(time.Microsecond)
+}
+
+// UnixNanoChecked is like UnixNano but returns ErrOutOfRange when the result
+// does not fit in an int64.
+func (t Time) UnixNanoChecked() (int64, error) {
+	return t.unixChecked(time.Nanosecond)
+}
+
+func (t Time) unixChecked(unit time.Duration) (int64, error) {
+	perSec := int64(time.Second / unit)
+	sec, frac := t.utc().Unix(), int64(t.utc().Nanosecond())/int64(unit)
+	maxSec, maxFrac := int64(math.MaxInt64)/perSec, int64(math.MaxInt64)%perSec
+	minSec, minFrac := int64(math.MinInt64)/perSec, int64(math.MinInt64)%perSec // minFrac <= 0
+	switch {
+	case sec > maxSec || sec == maxSec && frac > maxFrac,
+		sec < minSec && (sec < minSec-1 || frac < perSec+minFrac):
+		return 0, fmt.Errorf("%s in Unix %s: %w", t, unitName(unit), ErrOutOfRange)
+	case sec < minSec:
+		// sec*perSec alone overflows; borrow a second from the fraction.
+		return (sec+1)*perSec + (frac - perSec), nil
+	}
+	return sec*perSec + frac, nil
+}
@@ -0,0 +1,157 @@
+package utc
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestUnixConstructors(t *testing.T) {
+	want := time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC)
+
+	if got := Unix(1704164645, 123456789); !got.UTC().Equal(want) {
+		t.Errorf("Unix() = %v, want %v", got, want)
+	}
+	if got := Unix(1704164646, -876543211); !got.UTC().Equal(want) {
+		t.Errorf("Unix() with negative nsec = %v, want %v", got, want)
+	}
+	if got := FromUnixMicro(1704164645123456); !got.UTC().Equal(want.Truncate(time.Microsecond)) {
+		t.Errorf("FromUnixMicro() = %v", got)
+	}
+	if got := FromUnixNano(1704164645123456789); !got.UTC().Equal(want) {
+		t.Errorf("FromUnixNano() = %v", got)
+	}
+	if got := FromUnixNano(-1); !got.UTC().Equal(time.Date(1969, 12, 31, 23, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("FromUnixNano(-1) = %v", got)
+	}
+
+	got := FromUnixFloat(1704164645.123456)
+	if d := got.Sub(New(want)); d < -time.Microsecond || d > time.Microsecond {
+		t.Errorf("FromUnixFloat() = %v, want within 1µs of %v", got, want)
+	}
+	if got := FromUnixFloat(-1.25); !got.UTC().Equal(time.Date(1969, 12, 31, 23, 59, 58, 750000000, time.UTC)) {
+		t.Errorf("FromUnixFloat(-1.25) = %v", got)
+	}
+	for _, bad := range []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1e300} {
+		if got := FromUnixFloat(bad); !got.IsZero() {
+			t.Errorf("FromUnixFloat(%v) = %v, want zero", bad, got)
+		}
+		if _, err := FromUnixFloatChecked(bad); !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("FromUnixFloatChecked(%v) error = %v, want ErrOutOfRange", bad, err)
+		}
+	}
+}
+
+func TestUnixChecked(t *testing.T) {
+	tests := []struct {
+		name    string
+		sec     int64
+		nsec    int64
+		wantErr bool
+	}{
+		{"epoch", 0, 0, false},
+		{"carry", 1, 2 * int64(time.Second), false},
+		{"negative carry", 1, -2 * int64(time.Second), false},
+		{"max", maxUnixSec, 0, false},
+		{"past max", maxUnixSec, int64(time.Second), true},
+		{"min", math.MinInt64, 0, false},
+		{"below min", math.MinInt64, -1, true},
+		{"overflow sec", math.MaxInt64, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnixChecked(tt.sec, tt.nsec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnixChecked(%d, %d) error = %v, wantErr %v", tt.sec, tt.nsec, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrOutOfRange) {
+					t.Errorf("error = %v, want ErrOutOfRange", err)
+				}
+				return
+			}
+			if want := Unix(tt.sec, tt.nsec); !got.Equal(want) {
+				t.Errorf("UnixChecked() = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestUnixAccessors(t *testing.T) {
+	ut := New(time.Date(2024, 1, 2, 3, 4, 5, 123456789, time.UTC))
+	if got := ut.UnixMicro(); got != 1704164645123456 {
+		t.Errorf("UnixMicro() = %d", got)
+	}
+	if got := ut.UnixNano(); got != 1704164645123456789 {
+		t.Errorf("UnixNano() = %d", got)
+	}
+	if got := ut.UnixFloat(); math.Abs(got-1704164645.123456789) > 1e-6 {
+		t.Errorf("UnixFloat() = %f", got)
+	}
+	if got := Unix(-2, 500000000).UnixFloat(); got != -1.5 {
+		t.Errorf("UnixFloat() = %f, want -1.5", got)
+	}
+
+	checked := []struct {
+		name string
+		fn   func(Time) (int64, error)
+		want int64
+	}{
+		{"UnixMilliChecked", Time.UnixMilliChecked, 1704164645123},
+		{"UnixMicroChecked", Time.UnixMicroChecked, 1704164645123456},
+		{"UnixNanoChecked", Time.UnixNanoChecked, 1704164645123456789},
+	}
+	for _, c := range checked {
+		if got, err := c.fn(ut); err != nil || got != c.want {
+			t.Errorf("%s() = %d, %v; want %d", c.name, got, err, c.want)
+		}
+	}
+	if got, err := FromUnixNano(-1).UnixNanoChecked(); err != nil || got != -1 {
+		t.Errorf("UnixNanoChecked() before epoch = %d, %v", got, err)
+	}
+
+	if _, err := (Time{}).UnixNanoChecked(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("UnixNanoChecked(zero) error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := New(time.Date(2300, 1, 1, 0, 0, 0, 0, time.UTC)).UnixNanoChecked(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("UnixNanoChecked(2300) error = %v, want ErrOutOfRange", err)
+	}
+	if _, err := (Time{}).UnixMicroChecked(); err != nil {
+		t.Errorf("UnixMicroChecked(zero) error = %v", err)
+	}
+	if _, err := Unix(math.MinInt64/2, 0).UnixMilliChecked(); !errors.Is(err, ErrOutOfRange) {
+		t.Errorf("UnixMilliChecked(far past) error = %v, want ErrOutOfRange", err)
+	}
+
+	bounds := []struct {
+		name    string
+		t       Time
+		fn      func(Time) (int64, error)
+		want    int64
+		wantErr bool
+	}{
+		{"nano max", FromUnixNano(math.MaxInt64), Time.UnixNanoChecked, math.MaxInt64, false},
+		{"nano max whole second", Unix(9223372036, 800000000), Time.UnixNanoChecked, 9223372036800000000, false},
+		{"nano max+1", FromUnixNano(math.MaxInt64).Add(1), Time.UnixNanoChecked, 0, true},
+		{"nano min", FromUnixNano(math.MinInt64), Time.UnixNanoChecked, math.MinInt64, false},
+		{"nano min whole second", Unix(-9223372036, 0), Time.UnixNanoChecked, -9223372036000000000, false},
+		{"nano min-1", FromUnixNano(math.MinInt64).Add(-1), Time.UnixNanoChecked, 0, true},
+		{"micro max", FromUnixMicro(math.MaxInt64), Time.UnixMicroChecked, math.MaxInt64, false},
+		{"micro min", FromUnixMicro(math.MinInt64), Time.UnixMicroChecked, math.MinInt64, false},
+		{"micro min-1", FromUnixMicro(math.MinInt64).Add(-time.Microsecond), Time.UnixMicroChecked, 0, true},
+		{"milli max", New(time.UnixMilli(math.MaxInt64)), Time.UnixMilliChecked, math.MaxInt64, false},
+		{"milli min", New(time.UnixMilli(math.MinInt64)), Time.UnixMilliChecked, math.MinInt64, false},
+		{"milli max+1", New(time.UnixMilli(math.MaxInt64)).Add(time.Millisecond), Time.UnixMilliChecked, 0, true},
+	}
+	for _, b := range bounds {
+		got, err := b.fn(b.t)
+		if b.wantErr {
+			if !errors.Is(err, ErrOutOfRange) {
+				t.Errorf("%s: got %d, %v; want ErrOutOfRange", b.name, got, err)
+			}
+		} else if err != nil || got != b.want {
+			t.Errorf("%s: got %d, %v; want %d", b.name, got, err, b.want)
+		}
+	}
+}
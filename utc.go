@@ -23,10 +23,7 @@
 package utc
 
 import (
-	"bytes"
 	"database/sql/driver"
-	"encoding/json"
-	"errors"
 	"fmt"
 	"time"
 )
@@ -164,43 +161,14 @@ func Parse(layout string, s string) (Time, error) {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface for Time.
+// It parses strings with the package default parser; see SetDefaultParser.
 func (t *Time) UnmarshalJSON(data []byte) error {
 	if t == nil {
 		debugLog("UnmarshalJSON() called on nil *Time receiver")
-		return errors.New("cannot unmarshal into nil utc.Time")
+		return newError(ErrNilReceiver, "cannot unmarshal into nil utc.Time")
 	}
-	data = bytes.TrimSpace(data)
-
-	// Handle empty data
-	if len(data) == 0 {
-		return errors.New("cannot unmarshal empty data into utc.Time")
-	}
-
-	// Handle null
-	if string(data) == "null" {
-		t.t = time.Time{}
-		return nil
-	}
-
-	var s string
-	if err := json.Unmarshal(data, &s); err != nil {
-		return fmt.Errorf("utc.Time must be a JSON string or null: %w", err)
-	}
-
-	if s == "" {
-		t.t = time.Time{}
-		return nil
-	}
-
-	// Parse the time (allow a few flexible formats)
-	parsedTime, err := parse(s)
-	if err != nil {
-		return err
-	}
-
-	// Convert to UTC
-	t.t = parsedTime.UTC()
-	return nil
+	p := currentParser()
+	return p.decodeJSON(t, data)
 }
 
 // MarshalJSON implements the json.Marshaler interface for Time.
@@ -208,60 +176,39 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 func (t *Time) MarshalJSON() ([]byte, error) {
 	if t == nil {
 		debugLog("MarshalJSON() called on nil *Time receiver")
-		return nil, errors.New("cannot marshal nil utc.Time")
+		return nil, newError(ErrNilReceiver, "cannot marshal nil utc.Time")
 	}
-	return t.utc().MarshalJSON()
+	return marshalJSON(t.utc(), DefaultJSONFormat())
 }
 
 // MarshalText implements encoding.TextMarshaler.
 func (t Time) MarshalText() ([]byte, error) {
+	if inf, ok := t.infinityString(); ok {
+		return []byte(inf), nil
+	}
 	return t.utc().MarshalText()
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
+// It parses text with the package default parser; see SetDefaultParser.
 func (t *Time) UnmarshalText(text []byte) error {
 	if t == nil {
 		debugLog("UnmarshalText() called on nil *Time receiver")
-		return errors.New("cannot unmarshal text into nil utc.Time")
-	}
-	if len(text) == 0 {
-		t.t = time.Time{}
-		return nil
+		return newError(ErrNilReceiver, "cannot unmarshal text into nil utc.Time")
 	}
-	parsed, err := parse(string(text))
-	if err != nil {
-		return err
-	}
-	t.t = parsed.UTC()
-	return nil
+	p := currentParser()
+	return p.decodeText(t, text)
 }
 
 // UnmarshalYAML implements the yaml.Unmarshaler interface for Time.
+// It parses strings with the package default parser; see SetDefaultParser.
 func (t *Time) UnmarshalYAML(unmarshal func(any) error) error {
 	if t == nil {
 		debugLog("UnmarshalYAML() called on nil *Time receiver")
-		return errors.New("cannot unmarshal YAML into nil utc.Time")
+		return newError(ErrNilReceiver, "cannot unmarshal YAML into nil utc.Time")
 	}
-
-	var s string
-	if err := unmarshal(&s); err != nil {
-		return err
-	}
-
-	// Handle empty string
-	if s == "" {
-		t.t = time.Time{}
-		return nil
-	}
-
-	// Parse the time string using our flexible parser
-	parsed, err := parse(s)
-	if err != nil {
-		return fmt.Errorf("failed to parse time %q: %w", s, err)
-	}
-
-	t.t = parsed.UTC()
-	return nil
+	p := currentParser()
+	return p.decodeYAML(t, unmarshal)
 }
 
 // MarshalYAML implements the yaml.Marshaler interface for Time.
@@ -269,6 +216,9 @@ func (t Time) MarshalYAML() (any, error) {
 	if t.utc().IsZero() {
 		return nil, nil
 	}
+	if inf, ok := t.infinityString(); ok {
+		return inf, nil
+	}
 
 	// Use RFC3339Nano format so YAML preserves sub-second precision.
 	return t.utc().Format(time.RFC3339Nano), nil
@@ -276,49 +226,34 @@ func (t Time) MarshalYAML() (any, error) {
 
 // String implements fmt.Stringer. It prints the time in RFC3339Nano format.
 func (t Time) String() string {
+	if inf, ok := t.infinityString(); ok {
+		return inf
+	}
 	return t.utc().Format(time.RFC3339Nano)
 }
 
 // Value implements driver.Valuer for database operations.
-// It returns the UTC time.Time value as a driver.Value.
+// It returns the UTC time.Time value as a driver.Value, truncated to the
+// precision set by SetValuePrecision. Infinite values are returned as the
+// strings "infinity" and "-infinity", which PostgreSQL accepts.
 func (t Time) Value() (driver.Value, error) {
+	if inf, ok := t.infinityString(); ok {
+		return inf, nil
+	}
 	// Preserve previous behavior: zero value still returns a non-nil time
-	return t.utc(), nil
+	return t.utc().Truncate(time.Duration(ValuePrecision())), nil
 }
 
 // Scan implements sql.Scanner for database operations.
 // It accepts time.Time, string, and []byte values and stores them in UTC.
+// Strings are parsed with the package default parser; see SetDefaultParser.
 func (t *Time) Scan(value any) error {
 	if t == nil {
 		debugLog("Scan() called on nil *Time receiver")
-		return errors.New("cannot scan into nil utc.Time")
-	}
-
-	if value == nil {
-		return errors.New("cannot scan nil into utc.Time")
-	}
-
-	switch v := value.(type) {
-	case time.Time:
-		t.t = v.UTC()
-		return nil
-	case string:
-		parsed, err := parse(v)
-		if err != nil {
-			return err
-		}
-		t.t = parsed.UTC()
-		return nil
-	case []byte:
-		parsed, err := parse(string(v))
-		if err != nil {
-			return err
-		}
-		t.t = parsed.UTC()
-		return nil
-	default:
-		return errors.New("cannot scan non-time value into utc.Time")
+		return newError(ErrNilReceiver, "cannot scan into nil utc.Time")
 	}
+	p := currentParser()
+	return p.decodeSQL(t, value)
 }
 
 // Before reports whether the time is before u
@@ -338,6 +273,9 @@ func (t Time) Equal(u Time) bool {
 
 // Add returns the time t+d
 func (t Time) Add(d time.Duration) Time {
+	if t.IsInfinite() {
+		return t
+	}
 	return New(t.utc().Add(d))
 }
 
@@ -431,6 +369,18 @@ func (t Time) RFC3339Nano() string {
 	return t.utc().Format(time.RFC3339Nano)
 }
 
+// RFC3339Milli formats time as "2006-01-02T15:04:05.000Z07:00", always
+// writing three fractional digits. Finer precision is truncated.
+func (t Time) RFC3339Milli() string {
+	return t.utc().Format(rfc3339Milli)
+}
+
+// RFC3339Micro formats time as "2006-01-02T15:04:05.000000Z07:00", always
+// writing six fractional digits. Finer precision is truncated.
+func (t Time) RFC3339Micro() string {
+	return t.utc().Format(rfc3339Micro)
+}
+
 // ISO8601 formats time as "2006-01-02T15:04:05Z07:00" (same as RFC3339)
 func (t Time) ISO8601() string {
 	return t.utc().Format(time.RFC3339)
@@ -585,35 +535,21 @@ func FromUnixMilli(ms int64) Time { return New(time.UnixMilli(ms)) }
 func (t Time) Unix() int64        { return t.utc().Unix() }
 func (t Time) UnixMilli() int64   { return t.utc().UnixMilli() }
 
-// Day helpers - times are always in UTC within this package
+// Day helpers - times are always in UTC within this package.
+// PositiveInfinity and NegativeInfinity are returned unchanged.
 func (t Time) StartOfDay() Time {
+	if t.IsInfinite() {
+		return t
+	}
 	y, m, d := t.utc().Date()
 	return New(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))
 }
 
 func (t Time) EndOfDay() Time {
+	if t.IsInfinite() {
+		return t
+	}
 	y, m, d := t.utc().Date()
 	// One nanosecond before next midnight
 	return New(time.Date(y, m, d+1, 0, 0, 0, -1, time.UTC))
 }
-
-// Internal: parse a variety of common layouts to UTC.
-func parse(s string) (time.Time, error) {
-	tryLayouts := []string{
-		time.RFC3339Nano,
-		time.RFC3339,
-		"2006-01-02 15:04:05",
-		"2006-01-02",
-		"2006-01", // YYYY-MM format
-		"2006",    // YYYY format
-	}
-	var firstErr error
-	for _, layout := range tryLayouts {
-		if parsed, err := time.Parse(layout, s); err == nil {
-			return parsed.UTC(), nil
-		} else if firstErr == nil {
-			firstErr = err
-		}
-	}
-	return time.Time{}, firstErr
-}
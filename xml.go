@@ -0,0 +1,54 @@
+package utc
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MarshalXML implements xml.Marshaler. It writes t as the element's character
+// data in the same form as MarshalText.
+func (t Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	text, err := t.MarshalText()
+	if err != nil {
+		return err
+	}
+	return e.EncodeElement(string(text), start)
+}
+
+// UnmarshalXML implements xml.Unmarshaler. It parses the element's character
+// data, with surrounding whitespace removed, like UnmarshalText: an empty
+// element decodes as the zero Time.
+func (t *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if t == nil {
+		debugLog("UnmarshalXML() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal XML into nil utc.Time")
+	}
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	p := currentParser()
+	return p.decodeText(t, []byte(strings.TrimSpace(s)))
+}
+
+// MarshalXMLAttr implements xml.MarshalerAttr, so Time can be used in
+// fields tagged ",attr". The value has the same form as MarshalText.
+func (t Time) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	text, err := t.MarshalText()
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements xml.UnmarshalerAttr. It parses the attribute
+// value, with surrounding whitespace removed, like UnmarshalText: an empty
+// value decodes as the zero Time.
+func (t *Time) UnmarshalXMLAttr(attr xml.Attr) error {
+	if t == nil {
+		debugLog("UnmarshalXMLAttr() called on nil *Time receiver")
+		return newError(ErrNilReceiver, "cannot unmarshal XML attribute into nil utc.Time")
+	}
+	p := currentParser()
+	return p.decodeText(t, []byte(strings.TrimSpace(attr.Value)))
+}
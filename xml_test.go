@@ -0,0 +1,82 @@
+package utc
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+	"time"
+)
+
+type xmlFeed struct {
+	XMLName   xml.Name `xml:"feed"`
+	Updated   Time     `xml:"updated,attr"`
+	Published Time     `xml:"published"`
+	Expires   *Time    `xml:"expires,omitempty"`
+}
+
+func TestTime_XML(t *testing.T) {
+	updated := New(time.Date(2024, 1, 2, 3, 4, 5, 500000000, time.UTC))
+	published := New(time.Date(2023, 12, 31, 23, 0, 0, 0, time.UTC))
+	data, err := xml.Marshal(xmlFeed{Updated: updated, Published: published, Expires: &PositiveInfinity})
+	if err != nil {
+		t.Fatalf("xml.Marshal() error = %v", err)
+	}
+	want := `<feed updated="2024-01-02T03:04:05.5Z"><published>2023-12-31T23:00:00Z</published><expires>infinity</expires></feed>`
+	if string(data) != want {
+		t.Errorf("xml.Marshal() = %s, want %s", data, want)
+	}
+
+	var got xmlFeed
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	if got.Updated != updated || got.Published != published || got.Expires == nil || !got.Expires.Equal(PositiveInfinity) {
+		t.Errorf("xml.Unmarshal() = %+v", got)
+	}
+}
+
+func TestTime_UnmarshalXML(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want xmlFeed
+	}{
+		{
+			"offset and whitespace",
+			`<feed updated="2024-01-02T04:04:05+01:00"><published>
+				2024-01-02T03:04:05Z
+			</published></feed>`,
+			xmlFeed{Updated: Unix(1704164645, 0), Published: Unix(1704164645, 0)},
+		},
+		{"empty values", `<feed updated=""><published></published></feed>`, xmlFeed{}},
+		{"self-closing element", `<feed updated=" "><published/></feed>`, xmlFeed{}},
+		{"date only", `<feed updated="2024-01-02"><published> </published></feed>`, xmlFeed{Updated: New(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := xmlFeed{Updated: Now(), Published: Now()}
+			if err := xml.Unmarshal([]byte(tt.data), &got); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			if got.Updated != tt.want.Updated || got.Published != tt.want.Published {
+				t.Errorf("xml.Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	var feed xmlFeed
+	if err := xml.Unmarshal([]byte(`<feed updated="soon"/>`), &feed); err == nil {
+		t.Error("xml.Unmarshal() of an invalid attribute succeeded")
+	}
+	if err := xml.Unmarshal([]byte(`<feed><published>soon</published></feed>`), &feed); err == nil {
+		t.Error("xml.Unmarshal() of an invalid element succeeded")
+	}
+
+	var nilTime *Time
+	if err := nilTime.UnmarshalXMLAttr(xml.Attr{}); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalXMLAttr() error = %v, want ErrNilReceiver", err)
+	}
+	if err := nilTime.UnmarshalXML(nil, xml.StartElement{}); !errors.Is(err, ErrNilReceiver) {
+		t.Errorf("nil UnmarshalXML() error = %v, want ErrNilReceiver", err)
+	}
+}